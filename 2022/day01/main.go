@@ -49,7 +49,7 @@ func topThreeSum(calories []int) int {
 	return sum
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	data, err := parseData(utils.ReadFile("input.txt"))
 	if err != nil {
@@ -57,9 +57,10 @@ func part1() {
 	}
 	maxCalories := slices.Max(data)
 	fmt.Printf("Max calories: %d\n", maxCalories)
+	return utils.NewIntAnswer(maxCalories)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	data, err := parseData(utils.ReadFile("input.txt"))
 	if err != nil {
@@ -67,8 +68,11 @@ func part2() {
 	}
 	maxThree := topThreeSum(data)
 	fmt.Printf("Sum of highest three: %d\n", maxThree)
+	return utils.NewIntAnswer(maxThree)
 }
+
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -1,6 +1,11 @@
 package main
 
-import "testing"
+import (
+	"github.com/iamlucasvieira/aoc/utils"
+	"slices"
+	"strings"
+	"testing"
+)
 
 var mockData = []string{
 	"1000",
@@ -45,3 +50,34 @@ func TestTopThreeSum(t *testing.T) {
 	}
 
 }
+
+func FuzzParseData(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parseData(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data, err := parseData(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = slices.Max(data)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data, err := parseData(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		topThreeSum(data)
+	}
+}
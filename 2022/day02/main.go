@@ -132,7 +132,7 @@ func parse(lines []string) ([][2]string, error) {
 	return result, nil
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1")
 	rounds, err := parse(utils.ReadFile("input.txt"))
 	if err != nil {
@@ -140,9 +140,10 @@ func part1() {
 	}
 	s := score(rounds)
 	fmt.Printf("Score: %d\n", s)
+	return utils.NewIntAnswer(s)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2")
 	rounds, err := parse(utils.ReadFile("input2.txt"))
 	if err != nil {
@@ -150,8 +151,11 @@ func part2() {
 	}
 	s := scorePlayerChoice(rounds)
 	fmt.Printf("Score: %d\n", s)
+	return utils.NewIntAnswer(s)
 }
+
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
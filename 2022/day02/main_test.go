@@ -1,6 +1,10 @@
 package main
 
-import "testing"
+import (
+	"github.com/iamlucasvieira/aoc/utils"
+	"strings"
+	"testing"
+)
 
 var mockData = []string{
 	"A Y",
@@ -106,3 +110,34 @@ func TestScorePlayerChoice(t *testing.T) {
 		t.Errorf("scorePlayerChoice(%v) = %d; want %d", mockData, got, want)
 	}
 }
+
+func FuzzParse(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parse(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	rounds, err := parse(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		score(rounds)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	rounds, err := parse(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		scorePlayerChoice(rounds)
+	}
+}
@@ -101,19 +101,24 @@ func priorityOfSharedItemsThree(s []string) int {
 	return sum
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	data := utils.ReadFile("input.txt")
 	sum := priorityOfSharedItems(data)
 	fmt.Printf("Sum of priorities: %d\n", sum)
+	return utils.NewIntAnswer(sum)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	data := utils.ReadFile("input2.txt")
-	fmt.Printf("Shared items: %v\n", priorityOfSharedItemsThree(data))
+	sum := priorityOfSharedItemsThree(data)
+	fmt.Printf("Shared items: %v\n", sum)
+	return utils.NewIntAnswer(sum)
 }
+
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
 	"testing"
 )
 
@@ -105,3 +106,21 @@ func TestPriorityOfSharedItemsThree(t *testing.T) {
 		t.Errorf("got %d; want %d", sum, want)
 	}
 }
+
+func BenchmarkPart1(b *testing.B) {
+	data := utils.ReadFile("input.txt")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		priorityOfSharedItems(data)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data := utils.ReadFile("input2.txt")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		priorityOfSharedItemsThree(data)
+	}
+}
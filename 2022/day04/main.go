@@ -3,22 +3,19 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/interval"
 	"strconv"
 	"strings"
 )
 
-type sequence struct {
-	start, end int
-}
-
-// parse parses the input into a slice of sequences.
-func parse(input []string) ([][2]sequence, error) {
-	var data [][2]sequence
+// parse parses the input into a slice of pairs of section intervals.
+func parse(input []string) ([][2]interval.Interval, error) {
+	var data [][2]interval.Interval
 	for _, line := range input {
 		if line == "" {
 			continue
 		}
-		var seq = [2]sequence{}
+		var seq = [2]interval.Interval{}
 
 		parts := strings.Split(line, ",")
 
@@ -43,7 +40,7 @@ func parse(input []string) ([][2]sequence, error) {
 			if err != nil {
 				return nil, fmt.Errorf("invalid input: %s", line)
 			}
-			seq[i] = sequence{start, end}
+			seq[i] = interval.Closed(start, end)
 
 		}
 		data = append(data, seq)
@@ -51,16 +48,13 @@ func parse(input []string) ([][2]sequence, error) {
 	return data, nil
 }
 
-// isWithin returns true if any of the sequence contains its pair.
-func isWithin(values [2]sequence) bool {
-	within := func(a, b sequence) bool {
-		return a.start >= b.start && a.end <= b.end
-	}
-	return within(values[0], values[1]) || within(values[1], values[0])
+// isWithin returns true if any of the intervals contains its pair.
+func isWithin(values [2]interval.Interval) bool {
+	return values[0].ContainsInterval(values[1]) || values[1].ContainsInterval(values[0])
 }
 
-// nWithin returns the number of sequences that contain their pair.
-func nWithin(values [][2]sequence) int {
+// nWithin returns the number of intervals that contain their pair.
+func nWithin(values [][2]interval.Interval) int {
 	var count int
 	for _, v := range values {
 		if isWithin(v) {
@@ -70,16 +64,13 @@ func nWithin(values [][2]sequence) int {
 	return count
 }
 
-// hasOverlap returns true if any of the sequence overlaps its pair.
-func hasOverlap(values [2]sequence) bool {
-	overlap := func(a, b sequence) bool {
-		return a.start >= b.start && a.start <= b.end
-	}
-	return overlap(values[0], values[1]) || overlap(values[1], values[0])
+// hasOverlap returns true if the intervals overlap.
+func hasOverlap(values [2]interval.Interval) bool {
+	return values[0].Overlaps(values[1])
 }
 
-// nOverlap returns the number of sequences that overlap their pair.
-func nOverlap(values [][2]sequence) int {
+// nOverlap returns the number of intervals that overlap their pair.
+func nOverlap(values [][2]interval.Interval) int {
 	var count int
 	for _, v := range values {
 		if hasOverlap(v) {
@@ -88,7 +79,7 @@ func nOverlap(values [][2]sequence) int {
 	}
 	return count
 }
-func part1() int {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	data, err := parse(utils.ReadFile("input.txt"))
 
@@ -97,10 +88,10 @@ func part1() int {
 	}
 	sum := nWithin(data)
 	fmt.Printf("Sum: %d\n", sum)
-	return sum
+	return utils.NewIntAnswer(sum)
 }
 
-func part2() int {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	data, err := parse(utils.ReadFile("input2.txt"))
 
@@ -109,10 +100,11 @@ func part2() int {
 	}
 	sum := nOverlap(data)
 	fmt.Printf("Sum: %d\n", sum)
-	return sum
+	return utils.NewIntAnswer(sum)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -1,6 +1,10 @@
 package main
 
-import "testing"
+import (
+	"github.com/iamlucasvieira/aoc/utils"
+	"strings"
+	"testing"
+)
 
 var mockData = []string{
 	"2-4,6-8",
@@ -21,20 +25,20 @@ func TestParse(t *testing.T) {
 		t.Errorf("parse() len(data) = %v, want %v", len(data), len(mockData))
 	}
 
-	if data[0][0].start != 2 {
-		t.Errorf("parse() data[0][0].start = %v, want %v", data[0][0].start, 2)
+	if data[0][0].Start != 2 {
+		t.Errorf("parse() data[0][0].Start = %v, want %v", data[0][0].Start, 2)
 	}
 
-	if data[0][0].end != 4 {
-		t.Errorf("parse() data[0][0].end = %v, want %v", data[0][0].end, 4)
+	if data[0][0].Last() != 4 {
+		t.Errorf("parse() data[0][0].Last() = %v, want %v", data[0][0].Last(), 4)
 	}
 
-	if data[0][1].start != 6 {
-		t.Errorf("parse() data[0][1].start = %v, want %v", data[0][1].start, 6)
+	if data[0][1].Start != 6 {
+		t.Errorf("parse() data[0][1].Start = %v, want %v", data[0][1].Start, 6)
 	}
 
-	if data[0][1].end != 8 {
-		t.Errorf("parse() data[0][1].end = %v, want %v", data[0][1].end, 8)
+	if data[0][1].Last() != 8 {
+		t.Errorf("parse() data[0][1].Last() = %v, want %v", data[0][1].Last(), 8)
 	}
 }
 
@@ -100,17 +104,48 @@ func TestNOverlap(t *testing.T) {
 }
 
 func TestPart1(t *testing.T) {
-	want := 651
+	want := utils.NewIntAnswer(651)
 	got := part1()
-	if got != want {
+	if !got.Equal(want) {
 		t.Errorf("part1() = %v, want %v", got, want)
 	}
 }
 
 func TestPart2(t *testing.T) {
-	want := 956
+	want := utils.NewIntAnswer(956)
 	got := part2()
-	if got != want {
+	if !got.Equal(want) {
 		t.Errorf("part2() = %v, want %v", got, want)
 	}
 }
+
+func FuzzParse(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parse(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data, err := parse(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		nWithin(data)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data, err := parse(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		nOverlap(data)
+	}
+}
@@ -3,7 +3,7 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
-	"strconv"
+	parseutil "github.com/iamlucasvieira/aoc/utils/parse"
 	"strings"
 )
 
@@ -13,6 +13,13 @@ type rule struct {
 	n, from, to int
 }
 
+// ruleLine is the shape of a rule in the input, e.g. "move 1 from 2 to 1"
+type ruleLine struct {
+	N    int `parse:"move (\\d+)"`
+	From int `parse:" from (\\d+)"`
+	To   int `parse:" to (\\d+)"`
+}
+
 // moveMultiple pops n boxes from stack and push them in the original order to another stack
 func moveMultiple(n int, from, to *stack) error {
 	var boxes []string
@@ -36,32 +43,21 @@ func parse(lines []string) ([]stack, []rule, error) {
 	var boxes [][]string
 	var stacks []stack
 	var firstLine bool = true
-	for _, line := range lines {
+	for i, line := range lines {
 		if len(line) == 0 {
 			continue
 		}
 
 		if line[0] == 'm' {
-			parts := strings.Split(line, " ")
-			// move 1 from 2 to 1
-			if len(parts) != 6 {
-				return nil, nil, fmt.Errorf("parse: Wrong number of parts in %s", line)
-			}
-
-			nStrings := []string{parts[1], parts[3], parts[5]}
-			nIntegers := make([]int, 3)
-			for i, n := range nStrings {
-				nInt, err := strconv.Atoi(n)
-				if err != nil {
-					return nil, nil, fmt.Errorf("parse: %s is not an int", n)
-				}
-				nIntegers[i] = nInt
+			var r ruleLine
+			if err := parseutil.UnmarshalLine(line, &r); err != nil {
+				return nil, nil, fmt.Errorf("parse: %w", parseutil.AtLine(i+1, err))
 			}
 
 			rules = append(rules, rule{
-				n:    nIntegers[0],
-				from: nIntegers[1] - 1, // 1-based to 0-based
-				to:   nIntegers[2] - 1, // 1-based to 0-based
+				n:    r.N,
+				from: r.From - 1, // 1-based to 0-based
+				to:   r.To - 1,   // 1-based to 0-based
 			})
 
 		} else {
@@ -78,6 +74,12 @@ func parse(lines []string) ([]stack, []rule, error) {
 
 			// In every line that contains boxes, we populate stacks with boxes
 			for i := 0; i < len(line); i += 4 {
+				if i/4 >= len(boxes) {
+					return nil, nil, fmt.Errorf("parse: Line %q has more stacks than the first line", line)
+				}
+				if i+1 >= len(line) {
+					break
+				}
 				box := line[i+1]
 				if box != ' ' && strings.Contains(line, "[") {
 					boxes[i/4] = append(boxes[i/4], string(box))
@@ -86,6 +88,13 @@ func parse(lines []string) ([]stack, []rule, error) {
 		}
 	}
 
+	// Check that every rule moves boxes between existing stacks
+	for _, r := range rules {
+		if r.from < 0 || r.from >= len(stacks) || r.to < 0 || r.to >= len(stacks) {
+			return nil, nil, fmt.Errorf("parse: Rule %v refers to a stack that does not exist", r)
+		}
+	}
+
 	// Populate stacks with boxes
 	for i := range stacks {
 		for j := range boxes[i] {
@@ -134,47 +143,48 @@ func topMessage(stacks []stack) string {
 	return message
 }
 
-func part1() string {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	s, r, err := parse(utils.ReadFile("input.txt"))
 	if err != nil {
 		fmt.Println(err)
-		return ""
+		return utils.Answer{}
 	}
 
 	err = moveBoxes(&s, r)
 
 	if err != nil {
 		fmt.Println(err)
-		return ""
+		return utils.Answer{}
 	}
 
-	m := topMessage(s)
+	m := utils.NewStringAnswer(topMessage(s))
 	fmt.Printf("The message is: %s\n", m)
 	return m
 }
 
-func part2() string {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	s, r, err := parse(utils.ReadFile("input2.txt"))
 	if err != nil {
 		fmt.Println(err)
-		return ""
+		return utils.Answer{}
 	}
 
 	err = moveBoxesWithMultiples(&s, r)
 
 	if err != nil {
 		fmt.Println(err)
-		return ""
+		return utils.Answer{}
 	}
 
-	m := topMessage(s)
+	m := utils.NewStringAnswer(topMessage(s))
 	fmt.Printf("The message is: %s\n", m)
 	return m
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
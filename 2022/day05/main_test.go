@@ -1,6 +1,10 @@
 package main
 
-import "testing"
+import (
+	"github.com/iamlucasvieira/aoc/utils"
+	"strings"
+	"testing"
+)
 
 var mockData = []string{
 	"    [D]    ",
@@ -134,8 +138,8 @@ func TestTopMessage(t *testing.T) {
 
 func TestPart1(t *testing.T) {
 	msg := part1()
-	if msg != "BSDMQFLSP" {
-		t.Errorf("part1: Expected BSDMQFLSP, got %s", msg)
+	if want := utils.NewStringAnswer("BSDMQFLSP"); !msg.Equal(want) {
+		t.Errorf("part1: Expected %s, got %s", want, msg)
 	}
 }
 
@@ -244,7 +248,52 @@ func TestTopMessageWithMultiples(t *testing.T) {
 
 func TestPart2(t *testing.T) {
 	msg := part2()
-	if msg != "PGSQBFLDP" {
-		t.Errorf("part2: Expected BSDMQFLSP, got %s", msg)
+	if want := utils.NewStringAnswer("PGSQBFLDP"); !msg.Equal(want) {
+		t.Errorf("part2: Expected %s, got %s", want, msg)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _, _ = parse(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	lines := utils.ReadFile("input.txt")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Boxes are moved in place, so every iteration starts from freshly parsed stacks
+		b.StopTimer()
+		s, r, err := parse(lines)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+
+		if err := moveBoxes(&s, r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	lines := utils.ReadFile("input2.txt")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Boxes are moved in place, so every iteration starts from freshly parsed stacks
+		b.StopTimer()
+		s, r, err := parse(lines)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+
+		if err := moveBoxesWithMultiples(&s, r); err != nil {
+			b.Fatal(err)
+		}
 	}
 }
@@ -39,23 +39,24 @@ func firstUniqueSequence(line string, n int) int {
 	return -1
 }
 
-func part1() int {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	lines := parse(utils.ReadFile("input.txt"))
 	value := firstUniqueSequence(lines[0], 4)
 	fmt.Println(value)
-	return value
+	return utils.NewIntAnswer(value)
 }
 
-func part2() int {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	lines := parse(utils.ReadFile("input.txt"))
 	value := firstUniqueSequence(lines[0], 14)
 	fmt.Println(value)
-	return value
+	return utils.NewIntAnswer(value)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"github.com/iamlucasvieira/aoc/utils"
+	"strings"
 	"testing"
 )
 
@@ -57,3 +59,29 @@ func TestFirstUniqueSequence14(t *testing.T) {
 		})
 	}
 }
+
+func FuzzParse(f *testing.F) {
+	f.Add("mjqjpqmgbljsphdztnvjfqwrcgsmlb")
+	f.Add("a\n\nb")
+	f.Fuzz(func(t *testing.T, input string) {
+		_ = parse(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	lines := parse(utils.ReadFile("input.txt"))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		firstUniqueSequence(lines[0], 4)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	lines := parse(utils.ReadFile("input.txt"))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		firstUniqueSequence(lines[0], 14)
+	}
+}
@@ -162,22 +162,25 @@ func decodeCalibrationWritten(encodedCode string) int {
 }
 
 // part1 solves part 1 of challenge
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Running part 1")
 	data := utils.ReadFile("input.txt")
 	totalSum := sumCodes(data, decodeCalibration)
 	fmt.Printf("Total sum: %d\n", totalSum)
+	return utils.NewIntAnswer(totalSum)
 }
 
 // part2 solves part 2 of challenge
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Running part 2")
 	data := utils.ReadFile("input2.txt")
 	totalSum := sumCodes(data, decodeCalibrationWritten)
 	fmt.Printf("Total sum: %d\n", totalSum)
+	return utils.NewIntAnswer(totalSum)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -131,3 +131,21 @@ func TestPossibleWordsStarts(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkPart1(b *testing.B) {
+	data := utils.ReadFile("input.txt")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumCodes(data, decodeCalibration)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data := utils.ReadFile("input2.txt")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumCodes(data, decodeCalibrationWritten)
+	}
+}
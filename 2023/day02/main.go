@@ -3,9 +3,7 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
-	"regexp"
-	"strconv"
-	"strings"
+	"github.com/iamlucasvieira/aoc/utils/parse"
 )
 
 // Game is a game of cubes
@@ -21,39 +19,43 @@ type round struct {
 	green int
 }
 
-// newGame creates a new game from a string
-func newGame(gameString string) (game, error) {
-	// Split game and rounds by :
-	gameParts := strings.Split(gameString, ":")
+// gameLine is the shape of a game in the input, e.g. "Game 1: 3 blue, 4 red; 1 red, 2 green"
+type gameLine struct {
+	ID     int         `parse:"Game (\\d+):"`
+	Rounds []roundLine `parse:"(.*)" sep:";"`
+}
 
-	// Define regexes
-	gameIDRegex := regexp.MustCompile(`Game (\d+)`)
-	colorRegex := regexp.MustCompile(`(\d+) (blue|red|green)`)
+// roundLine is the shape of a round in the input, e.g. "3 blue, 4 red"
+type roundLine struct {
+	Cubes []cubesLine `parse:"(.*)" sep:","`
+}
 
-	gameID, err := strconv.Atoi(gameIDRegex.FindStringSubmatch(gameParts[0])[1])
-	if err != nil {
-		return game{}, fmt.Errorf("error parsing game ID: %v", err)
+// cubesLine is the shape of the cubes of one color in the input, e.g. "3 blue"
+type cubesLine struct {
+	Count int    `parse:"(\\d+) "`
+	Color string `parse:"(blue|red|green)"`
+}
+
+// newGame creates a new game from a string
+func newGame(gameString string) (game, error) {
+	var line gameLine
+	if err := parse.UnmarshalLine(gameString, &line); err != nil {
+		return game{}, fmt.Errorf("invalid game %q: %w", gameString, err)
 	}
 
-	g := game{id: gameID}
+	g := game{id: line.ID}
 
-	// Loop through rounds
-	for i, roundString := range strings.Split(gameParts[1], ";") {
+	// Get number of cubes of each color in each round
+	for i, roundLine := range line.Rounds {
 		r := round{id: i}
-		// Get number of cubes of each color
-		matches := colorRegex.FindAllStringSubmatch(roundString, -1)
-		for _, match := range matches {
-			count, err := strconv.Atoi(match[1])
-			if err != nil {
-				return game{}, fmt.Errorf("error parsing color count: %v", err)
-			}
-			switch match[2] {
+		for _, cubes := range roundLine.Cubes {
+			switch cubes.Color {
 			case "blue":
-				r.blue = count
+				r.blue = cubes.Count
 			case "red":
-				r.red = count
+				r.red = cubes.Count
 			case "green":
-				r.green = count
+				r.green = cubes.Count
 			}
 		}
 		g.rounds = append(g.rounds, r)
@@ -79,24 +81,44 @@ func isGameValid(g game, nRed int, nGreen int, nBlue int) bool {
 	return true
 }
 
-// part1 solves part 1 of day 2
-func part1() {
-	fmt.Println("Part 1:")
-	data := utils.ReadFile("input.txt")
-	sum := 0
-
+// parseGames parses the games in the input, skipping empty lines
+func parseGames(data []string) ([]game, error) {
+	var games []game
 	for _, gameString := range data {
-		if gameString != "" {
-			game, err := newGame(gameString)
-			if err != nil {
-				fmt.Printf("Error parsing game: %v\n", err)
-			}
-			if isGameValid(game, 12, 13, 14) {
-				sum += game.id
-			}
+		if gameString == "" {
+			continue
+		}
+		g, err := newGame(gameString)
+		if err != nil {
+			return nil, err
 		}
+		games = append(games, g)
 	}
+	return games, nil
+}
+
+// sumValidGames sums the ids of the games possible with 12 red, 13 green and 14 blue cubes
+func sumValidGames(games []game) int {
+	sum := 0
+	for _, g := range games {
+		if isGameValid(g, 12, 13, 14) {
+			sum += g.id
+		}
+	}
+	return sum
+}
+
+// part1 solves part 1 of day 2
+func part1() utils.Answer {
+	fmt.Println("Part 1:")
+	games, err := parseGames(utils.ReadFile("input.txt"))
+	if err != nil {
+		fmt.Printf("Error parsing game: %v\n", err)
+		return utils.Answer{}
+	}
+	sum := sumValidGames(games)
 	fmt.Printf("Sum: %d\n", sum)
+	return utils.NewIntAnswer(sum)
 }
 
 func fewestCubes(g game) (int, int, int) {
@@ -116,25 +138,31 @@ func fewestCubes(g game) (int, int, int) {
 	return maxRed, maxGreen, maxBlue
 }
 
+// sumPowers sums the products of the fewest cubes of each color in each game
+func sumPowers(games []game) int {
+	sum := 0
+	for _, g := range games {
+		red, green, blue := fewestCubes(g)
+		sum += red * green * blue
+	}
+	return sum
+}
+
 // part2 solves part 2 of day 2
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
-	data := utils.ReadFile("input2.txt")
-	sum := 0
-	for _, gameString := range data {
-		if gameString != "" {
-			game, err := newGame(gameString)
-			if err != nil {
-				fmt.Printf("Error parsing game: %v\n", err)
-			}
-			red, green, blue := fewestCubes(game)
-			sum += red * green * blue
-		}
+	games, err := parseGames(utils.ReadFile("input2.txt"))
+	if err != nil {
+		fmt.Printf("Error parsing game: %v\n", err)
+		return utils.Answer{}
 	}
+	sum := sumPowers(games)
 	fmt.Printf("Sum: %d\n", sum)
+	return utils.NewIntAnswer(sum)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -120,3 +120,35 @@ func TestFewestCubes(t *testing.T) {
 		})
 	}
 }
+
+func FuzzNewGame(f *testing.F) {
+	f.Add("Game 1: 3 blue, 4 red; 1 red, 2 green, 6 blue; 2 green")
+	f.Add("Game 2: 1 blue, 2 green; 3 green, 4 blue, 1 red; 1 green, 1 blue")
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = newGame(input)
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	games, err := parseGames(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumValidGames(games)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	games, err := parseGames(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumPowers(games)
+	}
+}
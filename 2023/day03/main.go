@@ -207,23 +207,26 @@ func sumValidNumbersGear(g grid, chars []point) int {
 	return sum
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	data := utils.ReadFile("input.txt")
 	g, c := parseGrid(data)
 	sum := sumValidNumbers(g, c)
 	fmt.Printf("Sum: %d\n", sum)
+	return utils.NewIntAnswer(sum)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	data := utils.ReadFile("input2.txt")
 	g, c := parseGridGear(data)
 	sum := sumValidNumbersGear(g, c)
 	fmt.Printf("Sum: %d\n", sum)
+	return utils.NewIntAnswer(sum)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
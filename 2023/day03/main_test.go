@@ -1,6 +1,10 @@
 package main
 
-import "testing"
+import (
+	"github.com/iamlucasvieira/aoc/utils"
+	"strings"
+	"testing"
+)
 
 var mockGrid = []string{
 	"467..114..",
@@ -128,3 +132,30 @@ func TestSumValidNumbersGear(t *testing.T) {
 		t.Errorf("Expected %d, got %d", 467835, sum)
 	}
 }
+
+func FuzzParseGrid(f *testing.F) {
+	f.Add(strings.Join(mockGrid, "\n"))
+	f.Add(strings.Join(mockGridGear, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parseGrid(strings.Split(input, "\n"))
+		_, _ = parseGridGear(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	g, c := parseGrid(utils.ReadFile("input.txt"))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumValidNumbers(g, c)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	g, c := parseGridGear(utils.ReadFile("input2.txt"))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumValidNumbersGear(g, c)
+	}
+}
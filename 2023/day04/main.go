@@ -3,10 +3,9 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/parse"
 	"math"
-	"regexp"
 	"slices"
-	"strconv"
 	"strings"
 )
 
@@ -26,26 +25,12 @@ func parseCard(input string) ([]int, []int, error) {
 		return nil, nil, fmt.Errorf("invalid card format for numbers: %s", cardParts[1])
 	}
 
-	extractNumbers := func(n string) ([]int, error) {
-		nRegex := regexp.MustCompile(`\d+`)
-		var numbers []int
-
-		for _, match := range nRegex.FindAllString(n, -1) {
-			number, err := strconv.Atoi(match)
-			if err != nil {
-				return nil, err
-			}
-			numbers = append(numbers, number)
-		}
-		return numbers, nil
-	}
-
-	winningNumbers, err := extractNumbers(numberParts[0])
+	winningNumbers, err := parse.Ints(numberParts[0])
 	if err != nil {
 		return nil, nil, err
 	}
 
-	elfNumbers, err := extractNumbers(numberParts[1])
+	elfNumbers, err := parse.Ints(numberParts[1])
 	if err != nil {
 		return nil, nil, err
 	}
@@ -164,21 +149,24 @@ func cardsWon(cards []string) int {
 	return score
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	data := utils.ReadFile("input.txt")
 	score := scoreMultipleCards(data)
 	fmt.Println("Score:", score)
+	return utils.NewIntAnswer(score)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	data := utils.ReadFile("input2.txt")
 	score := cardsWon(data)
 	fmt.Println("Score:", score)
+	return utils.NewIntAnswer(score)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -159,3 +159,29 @@ func TestCardsWon(t *testing.T) {
 	}
 
 }
+
+func FuzzParseCard(f *testing.F) {
+	f.Add("Card 1: 41 48 83 86 17 | 83 86  6 31 17  9 48 53")
+	f.Add("Card 3:  1 21 53 59 44 | 69 82 63 72 16 21 14  1")
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _, _ = parseCard(input)
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data := utils.ReadFile("input.txt")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		scoreMultipleCards(data)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data := utils.ReadFile("input2.txt")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cardsWon(data)
+	}
+}
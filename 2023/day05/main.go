@@ -3,9 +3,8 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
-	"regexp"
-	"slices"
-	"strconv"
+	"github.com/iamlucasvieira/aoc/utils/interval"
+	"github.com/iamlucasvieira/aoc/utils/parse"
 	"strings"
 )
 
@@ -25,59 +24,24 @@ func (c *conversion) add(destination, source, width int) {
 	*c = append(*c, condition{destination, source, width})
 }
 
-// adaptRanges adapt the range seeds to fit the conversion condition limits.
-func (c *conversion) adaptRanges(rangeSeeds [][]int) [][]int {
-	adaptedRangeSeeds := make([][]int, 0)
-	remainingRangeSeeds := make([][]int, 0)
-
-	for _, rangeSeed := range rangeSeeds {
-		start := rangeSeed[0]
-		end := rangeSeed[1]
-		isWithinRange := false
-
-		for _, condition := range *c {
-
-			// Condition 1: start is inside the range
-			if start >= condition.source && start < condition.source+condition.width {
-
-				isWithinRange = true
-				// Condition 1.1: end is inside the range
-				if end >= condition.source && end < condition.source+condition.width {
-					adaptedRangeSeeds = append(adaptedRangeSeeds, []int{start, end})
-					break
-				} else {
-					// Condition 1.2: end is outside the range
-					adaptedRangeSeeds = append(adaptedRangeSeeds, []int{start, condition.source + condition.width - 1})
-					remainingRangeSeeds = append(remainingRangeSeeds, []int{condition.source + condition.width, end})
-				}
-
-			}
-		}
-		// Condition 2: start is outside the range
-		if !isWithinRange {
-			// Find the closest condition that initializing after the start
-			closestEnd := end
-			for _, condition := range *c {
-				if condition.source > start && condition.source < closestEnd {
-					closestEnd = condition.source
-				}
-			}
-			// Condition 2.1: end is inside the range
-			adaptedRangeSeeds = append(adaptedRangeSeeds, []int{start, closestEnd})
+// sources returns the interval of values the condition converts.
+func (c condition) sources() interval.Interval {
+	return interval.New(c.source, c.source+c.width)
+}
 
-			// Condition 2.2: end is outside the range
-			if closestEnd != end {
-				remainingRangeSeeds = append(remainingRangeSeeds, []int{closestEnd + 1, end})
-			}
-		}
-	}
+// convertSet converts every value of a set using the conversion.
+// Values a condition covers move to its destination, and values no condition covers stay the same.
+func (c conversion) convertSet(values interval.IntervalSet) interval.IntervalSet {
+	var converted interval.IntervalSet
+	for _, condition := range c {
+		covered := interval.NewIntervalSet(condition.sources())
 
-	if len(remainingRangeSeeds) > 0 {
-		adaptedRemainingSeeds := c.adaptRanges(remainingRangeSeeds)
-		adaptedRangeSeeds = append(adaptedRangeSeeds, adaptedRemainingSeeds...)
+		// Only the first condition covering a value converts it
+		moved := values.Intersect(covered).Shift(condition.destination - condition.source)
+		converted = converted.Union(moved)
+		values = values.Difference(covered)
 	}
-
-	return adaptedRangeSeeds
+	return converted.Union(values)
 }
 
 type instruction struct {
@@ -99,29 +63,6 @@ func (i instruction) convert(v int, c conversion) int {
 	return v
 }
 
-func (i instruction) adaptAndConvertRanges(v [][]int, c conversion) [][]int {
-	// Adapt seed ranges for first conversion
-	adaptedRanges := c.adaptRanges(v)
-
-	// Get ranges for soil
-	convertedRanges := make([][]int, 0)
-
-	for _, adaptedRange := range adaptedRanges {
-		convertedRanges = append(convertedRanges, i.convertRange(adaptedRange, c))
-	}
-
-	return convertedRanges
-}
-
-// convertRange converts a list of values using the conversion.
-func (i instruction) convertRange(v []int, c conversion) []int {
-	converted := make([]int, len(v))
-	for idx, value := range v {
-		converted[idx] = i.convert(value, c)
-	}
-	return converted
-}
-
 func (i instruction) convertSeedToLocation(seed int) int {
 	soil := i.convert(seed, i.seedToSoil)
 	fertilizer := i.convert(soil, i.soilToFertilizer)
@@ -133,14 +74,15 @@ func (i instruction) convertSeedToLocation(seed int) int {
 	return location
 }
 
-func (i instruction) seedRangesToLocationRanges(seedRanges [][]int) [][]int {
-	soil := i.adaptAndConvertRanges(seedRanges, i.seedToSoil)
-	fertilizer := i.adaptAndConvertRanges(soil, i.soilToFertilizer)
-	water := i.adaptAndConvertRanges(fertilizer, i.fertilizerToWater)
-	light := i.adaptAndConvertRanges(water, i.waterToLight)
-	temperature := i.adaptAndConvertRanges(light, i.lightToTemperature)
-	humidity := i.adaptAndConvertRanges(temperature, i.temperatureToHumidity)
-	location := i.adaptAndConvertRanges(humidity, i.humidityToLocation)
+// seedRangesToLocationRanges converts a set of seeds to the set of their locations.
+func (i instruction) seedRangesToLocationRanges(seeds interval.IntervalSet) interval.IntervalSet {
+	soil := i.seedToSoil.convertSet(seeds)
+	fertilizer := i.soilToFertilizer.convertSet(soil)
+	water := i.fertilizerToWater.convertSet(fertilizer)
+	light := i.waterToLight.convertSet(water)
+	temperature := i.lightToTemperature.convertSet(light)
+	humidity := i.temperatureToHumidity.convertSet(temperature)
+	location := i.humidityToLocation.convertSet(humidity)
 	return location
 }
 
@@ -152,40 +94,35 @@ func parseInstructions(data []string) ([]int, instruction, error) {
 	conversionData := make([]conversion, 7)
 	var idx = -1
 
-	// Used to get all numbers from a string
-	numberRegex := regexp.MustCompile(`\d+`)
-
-	for _, line := range data {
+	for i, line := range data {
 		if strings.HasSuffix(line, "map:") {
 			idx++
+			if idx >= len(conversionData) {
+				return nil, instruction{}, fmt.Errorf("expected %v maps, got more", len(conversionData))
+			}
 			conversionData[idx] = make(conversion, 0)
 		} else if line == "" {
 			continue
 		} else if strings.HasPrefix(line, "seeds:") {
-			// Get all seeds using regex
-			for _, seed := range numberRegex.FindAllString(line, -1) {
-				// Covert seed to int
-				intSeed, err := strconv.Atoi(seed)
-				if err != nil {
-					return nil, instruction{}, err
-				}
-				seeds = append(seeds, intSeed)
+			// Get all seeds
+			lineSeeds, err := parse.Ints(line)
+			if err != nil {
+				return nil, instruction{}, parse.AtLine(i+1, err)
 			}
+			seeds = append(seeds, lineSeeds...)
 
 		} else {
-			// Get all numbers using regex
-			numbers := numberRegex.FindAllString(line, -1)
-			if len(numbers) != 3 {
-				return nil, instruction{}, fmt.Errorf("expected 3 numbers, got %v", len(numbers))
+			if idx < 0 {
+				return nil, instruction{}, fmt.Errorf("conversion %q found before any map", line)
 			}
-			// Convert numbers to int
-			intNumbers := make([]int, 3)
-			for i, n := range numbers {
-				intN, err := strconv.Atoi(n)
-				if err != nil {
-					return nil, instruction{}, err
-				}
-				intNumbers[i] = intN
+
+			// Get all numbers
+			intNumbers, err := parse.Ints(line)
+			if err != nil {
+				return nil, instruction{}, parse.AtLine(i+1, err)
+			}
+			if len(intNumbers) != 3 {
+				return nil, instruction{}, parse.AtLine(i+1, fmt.Errorf("expected 3 numbers, got %v", len(intNumbers)))
 			}
 
 			// Add conversion
@@ -208,26 +145,23 @@ func parseInstructions(data []string) ([]int, instruction, error) {
 }
 
 // parseInstructionsRangeSeeds parses the instruction data. Returns a list of seeds, instruction, and error.
-// Seeds are pairs. First number is the start value and second number is the end value.
-func parseInstructionsRangeSeeds(data []string) ([][]int, instruction, error) {
+// Seeds are pairs. First number is the start value and second number is the number of seeds.
+func parseInstructionsRangeSeeds(data []string) ([]interval.Interval, instruction, error) {
 	seeds, instruction, err := parseInstructions(data)
 
 	if err != nil {
 		return nil, instruction, err
 	}
 
-	// Seeds are pairs. First number is the start value and second number is the end value.
-
 	if len(seeds)%2 != 0 {
 		return nil, instruction, fmt.Errorf("expected even number of seeds, got %v", len(seeds))
 	}
 
-	seedRanges := make([][]int, 0)
+	seedRanges := make([]interval.Interval, 0, len(seeds)/2)
 
 	for i := 0; i < len(seeds); i += 2 {
 		start := seeds[i]
-		end := start + seeds[i+1] - 1
-		seedRanges = append(seedRanges, []int{start, end})
+		seedRanges = append(seedRanges, interval.New(start, start+seeds[i+1]))
 	}
 	return seedRanges, instruction, err
 }
@@ -251,21 +185,13 @@ func closestLocation(seeds []int, instruction instruction) int {
 	return location
 }
 
-func closestLocationRangeSeeds(seeds [][]int, instruction instruction) int {
-	// Get the first seed's location
-	locations := instruction.seedRangesToLocationRanges(seeds)
-	closestLocation := locations[0][0]
-
-	// find the closest location
-	for _, location := range locations[1:] {
-		if currentMin := slices.Min(location); currentMin < closestLocation {
-			closestLocation = currentMin
-		}
-	}
+func closestLocationRangeSeeds(seeds []interval.Interval, instruction instruction) int {
+	locations := instruction.seedRangesToLocationRanges(interval.NewIntervalSet(seeds...))
+	closestLocation, _ := locations.Min()
 	return closestLocation
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	data := utils.ReadFile("input.txt")
 	seeds, instruction, err := parseInstructions(data)
@@ -274,9 +200,10 @@ func part1() {
 	}
 	c := closestLocation(seeds, instruction)
 	fmt.Printf("Closest location: %v\n", c)
+	return utils.NewIntAnswer(c)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	data := utils.ReadFile("input2.txt")
 	seeds, instruction, err := parseInstructionsRangeSeeds(data)
@@ -285,9 +212,11 @@ func part2() {
 	}
 	c := closestLocationRangeSeeds(seeds, instruction)
 	fmt.Printf("Closest location: %v\n", c)
+	return utils.NewIntAnswer(c)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
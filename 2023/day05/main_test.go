@@ -2,9 +2,11 @@ package main
 
 import (
 	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/interval"
 	"slices"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -124,11 +126,11 @@ func TestParseInstructionsRangeSeeds(t *testing.T) {
 		t.Errorf("Expected seeds length 2, got %v", len(seeds))
 	}
 
-	if seeds[0][0] != 79 || seeds[0][1] != 92 {
+	if seeds[0] != interval.Closed(79, 92) {
 		t.Errorf("Expected seeds[0] to be [79, 92], got %v", seeds[0])
 	}
 
-	if seeds[1][0] != 55 || seeds[1][1] != 67 {
+	if seeds[1] != interval.Closed(55, 67) {
 		t.Errorf("Expected seeds[1] to be [55, 67], got %v", seeds[1])
 	}
 }
@@ -144,56 +146,64 @@ func TestClosestLocationRangeSeeds(t *testing.T) {
 	}
 }
 
-func TestConversionAdaptRanges(t *testing.T) {
+func TestConversionConvertSet(t *testing.T) {
 	_, instruction, _ := parseInstructionsRangeSeeds(mockData)
 
-	seedToSoil := instruction.seedToSoil
-
-	if adapted := seedToSoil.adaptRanges([][]int{{50, 97}}); len(adapted) != 1 {
-		t.Errorf("Expected adapted length 1, got %v", len(adapted))
-	}
-	if adapted := seedToSoil.adaptRanges([][]int{{79, 99}}); len(adapted) != 2 {
-		t.Errorf("Expected adapted length 2, got %v", len(adapted))
+	testCases := []struct {
+		values interval.Interval
+		want   []interval.Interval
+	}{
+		{interval.Closed(0, 10), []interval.Interval{interval.Closed(0, 10)}},
+		{interval.Closed(98, 99), []interval.Interval{interval.Closed(50, 51)}},
+		{interval.Closed(50, 97), []interval.Interval{interval.Closed(52, 99)}},
+		{interval.Closed(79, 99), []interval.Interval{interval.Closed(50, 51), interval.Closed(81, 99)}},
+		{interval.Closed(10, 102), []interval.Interval{interval.Closed(10, 102)}},
 	}
 
-	if adapted := seedToSoil.adaptRanges([][]int{{79, 101}}); len(adapted) != 3 {
-		t.Errorf("Expected adapted length 3, got %v", len(adapted))
-	}
+	for _, tc := range testCases {
+		t.Run(tc.values.String(), func(t *testing.T) {
+			got := instruction.seedToSoil.convertSet(interval.NewIntervalSet(tc.values))
+
+			if !slices.Equal(got.Intervals(), tc.want) {
+				t.Errorf("Expected %v, got %v", tc.want, got.Intervals())
+			}
 
-	if adapted := seedToSoil.adaptRanges([][]int{{10, 102}}); len(adapted) != 4 {
-		t.Errorf("Expected adapted length 4, got %v", len(adapted))
+			// Every value goes somewhere, and no two values go to the same place
+			if got.Len() != tc.values.Len() {
+				t.Errorf("Expected %v values, got %v", tc.values.Len(), got.Len())
+			}
+		})
 	}
 }
 
-func TestConvertRange(t *testing.T) {
-	_, instruction, _ := parseInstructionsRangeSeeds(mockData)
+func FuzzParseInstructions(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _, _ = parseInstructions(strings.Split(input, "\n"))
+		_, _, _ = parseInstructionsRangeSeeds(strings.Split(input, "\n"))
+	})
+}
 
-	if value1 := instruction.convertRange([]int{0, 10}, instruction.seedToSoil); value1[0] != 0 || value1[1] != 10 {
-		t.Errorf("Expected convertRange([0, 10], seedToSoil) to be [0, 10], got %v", value1)
+func BenchmarkPart1(b *testing.B) {
+	seeds, instruction, err := parseInstructions(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
 	}
 
-	if value2 := instruction.convertRange([]int{98, 99}, instruction.seedToSoil); value2[0] != 50 || value2[1] != 51 {
-		t.Errorf("Expected convertRange([98, 99], seedToSoil) to be [50, 51], got %v", value2)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		closestLocation(seeds, instruction)
 	}
 }
 
-func TestAdaptAndConvertRange(t *testing.T) {
-	_, instruction, _ := parseInstructionsRangeSeeds(mockData)
-
-	ranges := [][]int{{79, 99}}
-
-	convertedRanges := instruction.adaptAndConvertRanges(ranges, instruction.seedToSoil)
-
-	if len(convertedRanges) != 2 {
-		t.Errorf("Expected convertedRanges length 2, got %v", len(convertedRanges))
-	}
-
-	if convertedRanges[0][0] != 81 || convertedRanges[0][1] != 99 {
-		t.Errorf("Expected convertedRanges[0] to be [81, 99], got %v", convertedRanges[0])
+func BenchmarkPart2(b *testing.B) {
+	seeds, instruction, err := parseInstructionsRangeSeeds(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
 	}
 
-	if convertedRanges[1][0] != 50 || convertedRanges[1][1] != 51 {
-		t.Errorf("Expected convertedRanges[1] to be [50, 51], got %v", convertedRanges[1])
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		closestLocationRangeSeeds(seeds, instruction)
 	}
-
 }
@@ -3,8 +3,8 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/parse"
 	"math"
-	"regexp"
 	"strconv"
 	"strings"
 )
@@ -79,21 +79,15 @@ type race struct {
 }
 
 // parseData Parses the data from the input file.
-func parseData(data []string) []race {
+func parseData(data []string) ([]race, error) {
 	var times, distances []int
 
-	// Define regex for parsing numbers
-	numberRegex := regexp.MustCompile(`\d+`)
-	for _, line := range data {
+	for i, line := range data {
 
 		// Create list with integers found
-		var listNumbers []int
-		for _, number := range numberRegex.FindAllString(line, -1) {
-			numberInt, err := strconv.Atoi(number)
-			if err != nil {
-				panic(err)
-			}
-			listNumbers = append(listNumbers, numberInt)
+		listNumbers, err := parse.Ints(line)
+		if err != nil {
+			return nil, parse.AtLine(i+1, err)
 		}
 		if strings.HasPrefix(line, "Time:") {
 			times = listNumbers
@@ -102,13 +96,17 @@ func parseData(data []string) []race {
 		}
 	}
 
+	if len(times) != len(distances) {
+		return nil, fmt.Errorf("got %d times and %d distances", len(times), len(distances))
+	}
+
 	var allRaces = make([]race, len(times))
 
 	for idx := range times {
 		allRaces[idx] = race{times[idx], distances[idx]}
 	}
 
-	return allRaces
+	return allRaces, nil
 }
 
 // buildSingleRace appends all integers to form a single time and distance
@@ -151,25 +149,34 @@ func productNumberBestSpeeds(races []race) int {
 	return product
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
-	data := parseData(utils.ReadFile("input.txt"))
+	data, err := parseData(utils.ReadFile("input.txt"))
+	if err != nil {
+		panic(err)
+	}
 	product := productNumberBestSpeeds(data)
 	fmt.Printf("Product: %d\n", product)
+	return utils.NewIntAnswer(product)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
-	data := parseData(utils.ReadFile("input2.txt"))
+	data, err := parseData(utils.ReadFile("input2.txt"))
+	if err != nil {
+		panic(err)
+	}
 	singleRace := buildSingleRace(data)
 	total, err := totalBestSpeeds(singleRace.time, singleRace.distance)
 	if err != nil {
 		panic(err)
 	}
 	fmt.Printf("Number of best speeds: %d\n", total)
+	return utils.NewIntAnswer(total)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
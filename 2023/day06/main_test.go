@@ -5,6 +5,7 @@ import (
 	"github.com/iamlucasvieira/aoc/utils"
 	"slices"
 	"sort"
+	"strings"
 	"testing"
 )
 
@@ -100,7 +101,10 @@ func TestBestSpeeds(t *testing.T) {
 }
 
 func TestParseData(t *testing.T) {
-	data := parseData(mockData)
+	data, err := parseData(mockData)
+	if err != nil {
+		t.Fatalf("parseData(mockData) returned error: %v", err)
+	}
 
 	if len(data) != 3 {
 		t.Errorf("Expected data length 3, got %v", len(data))
@@ -120,7 +124,10 @@ func TestParseData(t *testing.T) {
 }
 
 func TestProductNumberBestSpeeds(t *testing.T) {
-	data := parseData(mockData)
+	data, err := parseData(mockData)
+	if err != nil {
+		t.Fatalf("parseData(mockData) returned error: %v", err)
+	}
 
 	if got := productNumberBestSpeeds(data); got != 288 {
 		t.Errorf("productNumberBestSpeeds(%v) = %d, want %d", data, got, 288)
@@ -128,7 +135,10 @@ func TestProductNumberBestSpeeds(t *testing.T) {
 }
 
 func TestBuildSingleRace(t *testing.T) {
-	data := parseData(mockData)
+	data, err := parseData(mockData)
+	if err != nil {
+		t.Fatalf("parseData(mockData) returned error: %v", err)
+	}
 	singleRace := buildSingleRace(data)
 
 	if singleRace.time != 71530 {
@@ -141,7 +151,10 @@ func TestBuildSingleRace(t *testing.T) {
 }
 
 func TestTotalBestSpeeds(t *testing.T) {
-	data := parseData(mockData)
+	data, err := parseData(mockData)
+	if err != nil {
+		t.Fatalf("parseData(mockData) returned error: %v", err)
+	}
 	singleRace := buildSingleRace(data)
 	speeds, err := bestSpeeds(singleRace.time, singleRace.distance)
 
@@ -153,3 +166,37 @@ func TestTotalBestSpeeds(t *testing.T) {
 		t.Errorf("Expected speeds length 71503, got %d", len(speeds))
 	}
 }
+
+func FuzzParseData(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parseData(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data, err := parseData(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		productNumberBestSpeeds(data)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data, err := parseData(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	singleRace := buildSingleRace(data)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := totalBestSpeeds(singleRace.time, singleRace.distance); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
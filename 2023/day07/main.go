@@ -238,6 +238,10 @@ func parseDataTemplate(lines []string, hm handMaker) (hands, error) {
 		// Split the line into the cards and the bid.
 		parts := strings.Split(line, " ")
 
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid hand: %q", line)
+		}
+
 		var cards = make([]string, len(parts[0]))
 
 		// Create a slice of the cards.
@@ -272,7 +276,7 @@ func parseDataWildJ(lines []string) (hands, error) {
 	return parseDataTemplate(lines, makeHandWildJ)
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	data, err := parseData(utils.ReadFile("input.txt"))
 	if err != nil {
@@ -280,9 +284,10 @@ func part1() {
 	}
 	score := data.score()
 	fmt.Printf("The score is %d\n", score)
+	return utils.NewIntAnswer(score)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	data, err := parseDataWildJ(utils.ReadFile("input2.txt"))
 	if err != nil {
@@ -290,8 +295,11 @@ func part2() {
 	}
 	score := data.score()
 	fmt.Printf("The score is %d\n", score)
+	return utils.NewIntAnswer(score)
 }
+
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"slices"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -231,3 +232,43 @@ func TestHandsWildJ_Score(t *testing.T) {
 		t.Errorf("score() = %v, want %v", score, 5905)
 	}
 }
+
+func FuzzParseData(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parseData(strings.Split(input, "\n"))
+		_, _ = parseDataWildJ(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data, err := parseData(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// score sorts the hands in place, so each run starts from the unsorted input
+		b.StopTimer()
+		h := slices.Clone(data)
+		b.StartTimer()
+		h.score()
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data, err := parseDataWildJ(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// score sorts the hands in place, so each run starts from the unsorted input
+		b.StopTimer()
+		h := slices.Clone(data)
+		b.StartTimer()
+		h.score()
+	}
+}
@@ -3,6 +3,8 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/dot"
+	"sort"
 	"strings"
 )
 
@@ -64,6 +66,33 @@ func parseOption(input string) (string, lrPair, error) {
 	return name, lrPair(valuesParts), nil
 }
 
+// toDot returns the map of options as a DOT graph, with the left and right edges of each node.
+// Nodes ending in A are start nodes and nodes ending in Z are end nodes.
+func (m lrPairMap) toDot() *dot.Graph {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	g := dot.NewDigraph("network")
+	for _, name := range names {
+		n := g.Node(name)
+		switch {
+		case strings.HasSuffix(name, "A"):
+			n.Shape("Mdiamond")
+		case strings.HasSuffix(name, "Z"):
+			n.Shape("doublecircle")
+		}
+	}
+
+	for _, name := range names {
+		g.Edge(name, m[name][0]).Label("L")
+		g.Edge(name, m[name][1]).Label("R")
+	}
+	return g
+}
+
 // parseData parses the input and returns the instructions, the map of options, and the start position.
 func parseData(lines []string) ([]int, lrPairMap, error) {
 
@@ -154,13 +183,20 @@ func stepsToZ(instructions []int, options lrPairMap) (int, error) {
 	return utils.LCM(steps...), nil
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	instructions, options, err := parseData(utils.ReadFile("input.txt"))
 	if err != nil {
 		panic(err)
 	}
 
+	if path, ok := dot.OutputPath(); ok {
+		if err := options.toDot().Save(path); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Saved the network graph to %s\n", path)
+	}
+
 	var steps int
 	steps, err = stepsToZZZ(instructions, options)
 	if err != nil {
@@ -168,9 +204,10 @@ func part1() {
 	}
 
 	fmt.Printf("It takes %d steps to reach Z\n", steps)
+	return utils.NewIntAnswer(steps)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	instructions, options, err := parseData(utils.ReadFile("input2.txt"))
 	if err != nil {
@@ -184,8 +221,11 @@ func part2() {
 	}
 
 	fmt.Printf("It takes %d steps to reach Z\n", steps)
+	return utils.NewIntAnswer(steps)
 }
+
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"github.com/iamlucasvieira/aoc/utils"
 	"slices"
+	"strings"
 	"testing"
 )
 
@@ -123,6 +125,32 @@ func TestParseData(t *testing.T) {
 	}
 }
 
+func TestLrPairMap_ToDot(t *testing.T) {
+	_, options, err := parseData(mockData2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := strings.Join([]string{
+		`digraph "network" {`,
+		`	"AAA" [shape="Mdiamond"];`,
+		`	"BBB";`,
+		`	"ZZZ" [shape="doublecircle"];`,
+		`	"AAA" -> "BBB" [label="L"];`,
+		`	"AAA" -> "BBB" [label="R"];`,
+		`	"BBB" -> "AAA" [label="L"];`,
+		`	"BBB" -> "ZZZ" [label="R"];`,
+		`	"ZZZ" -> "ZZZ" [label="L"];`,
+		`	"ZZZ" -> "ZZZ" [label="R"];`,
+		`}`,
+		``,
+	}, "\n")
+
+	if got := options.toDot().String(); got != want {
+		t.Errorf("toDot() got\n%s\nwant\n%s", got, want)
+	}
+}
+
 func TestStepsToZZZ(t *testing.T) {
 	testCases := []struct {
 		data []string
@@ -167,3 +195,40 @@ func TestStepsToZ(t *testing.T) {
 	}
 
 }
+
+func FuzzParseData(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Add(strings.Join(mockData2, "\n"))
+	f.Add(strings.Join(mockData3, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _, _ = parseData(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	instructions, options, err := parseData(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := stepsToZZZ(instructions, options); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	instructions, options, err := parseData(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := stepsToZ(instructions, options); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -3,33 +3,14 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
-	"strconv"
-	"strings"
+	"github.com/iamlucasvieira/aoc/utils/parse"
 )
 
 // parseData parses the data into slices. Each line contains numbers separated by spaces.
 func parseData(lines []string) ([][]int, error) {
-
-	var numbersLists [][]int
-	for _, line := range lines {
-		if line == "" {
-			continue
-		}
-
-		var numbers []int
-		for _, number := range strings.Split(line, " ") {
-			// Turn the string into an int
-			n, err := strconv.Atoi(number)
-			if err != nil {
-				return nil, err
-			}
-			numbers = append(numbers, n)
-		}
-
-		numbersLists = append(numbersLists, numbers)
-
-	}
-	return numbersLists, nil
+	return parse.Lines(lines, func(line string) ([]int, error) {
+		return parse.IntList(line, " ")
+	})
 }
 
 // diff returns the difference between elements in a slice.
@@ -121,7 +102,7 @@ func sumPreviousNumbers(nLists [][]int) int {
 	return sumListPredictions(nLists, previousNumber)
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	data, err := parseData(utils.ReadFile("input.txt"))
 	if err != nil {
@@ -129,9 +110,10 @@ func part1() {
 	}
 	sum := sumNextNumbers(data)
 	fmt.Printf("Sum: %d\n", sum)
+	return utils.NewIntAnswer(sum)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	data, err := parseData(utils.ReadFile("input2.txt"))
 	if err != nil {
@@ -139,9 +121,11 @@ func part2() {
 	}
 	sum := sumPreviousNumbers(data)
 	fmt.Printf("Sum: %d\n", sum)
+	return utils.NewIntAnswer(sum)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
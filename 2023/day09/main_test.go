@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
 	"slices"
+	"strings"
 	"testing"
 )
 
@@ -28,6 +29,15 @@ func TestParseData(t *testing.T) {
 	}
 }
 
+func TestParseDataError(t *testing.T) {
+	_, err := parseData([]string{"0 3 6", "1 x 6"})
+
+	expected := `line 2, column 3: "x" is not an integer`
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+}
+
 func TestDiff(t *testing.T) {
 	testCases := []utils.TestCase[[]int, []int]{
 		{[]int{1, 2, 3, 4, 5}, []int{1, 1, 1, 1}},
@@ -161,3 +171,34 @@ func TestSumPreviousNumbers(t *testing.T) {
 		t.Errorf("Expected %d, got %d", expected, got)
 	}
 }
+
+func FuzzParseData(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parseData(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data, err := parseData(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumNextNumbers(data)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data, err := parseData(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumPreviousNumbers(data)
+	}
+}
@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
-	"slices"
 )
 
 // point is a struct that represents a point in a 2D plane.
@@ -126,26 +125,16 @@ func makePiece(name string, p point) (piece, error) {
 
 // parseData is a function that parses the data into a grid and finds the start point.
 func parseData(lines []string) (grid, point, error) {
+	g, markers, err := utils.ParseGridMarkers(lines, utils.Strings, "S")
+	if err != nil {
+		return nil, point{}, err
+	}
 
-	var grid grid
-	var start point
-	for yIdx, line := range lines {
-		if line == "" {
-			continue
-		}
-
-		var row []string
-		for xIdx, char := range line {
-			if char == 'S' {
-				start = point{xIdx, yIdx}
-			}
-			row = append(row, string(char))
-		}
-
-		grid = append(grid, row)
-
+	starts := markers['S']
+	if len(starts) != 1 {
+		return nil, point{}, fmt.Errorf("expected 1 start point, found %d", len(starts))
 	}
-	return grid, start, nil
+	return grid(g), point{starts[0].X, starts[0].Y}, nil
 }
 
 // Find the first piece connected to the start point.
@@ -208,80 +197,46 @@ func largestDistance(g grid, start point) (int, error) {
 	return (len(path) - 1) / 2, nil
 }
 
-func pointInPolygon(p point, g grid, polygon []point) bool {
-	var nCrossing int
-
-	// Check if point is in grid
-	if value := g.get(p); value == "" {
-		return false
+// toUtilsPath is a function that converts a path of points to the points used by utils.
+func toUtilsPath(polygon []point) []utils.Point {
+	converted := make([]utils.Point, len(polygon))
+	for i, p := range polygon {
+		converted[i] = utils.Point{X: p.x, Y: p.y}
 	}
-
-	for x := p.x + 1; x < len(g[p.y]); x++ {
-		nextP := point{x, p.y}
-
-		// Check if it encounters a piece of the polygon
-		for i := 1; i < len(polygon)-1; i++ {
-			previous, current, next := polygon[i-1], polygon[i], polygon[i+1]
-			if !current.equal(nextP) {
-				continue
-			}
-
-			if !(current.y == previous.y+1) && !(current.y == next.y+1) {
-				continue
-			}
-
-			nCrossing++
-
-		}
-	}
-	// If even number of crossings, point is outside
-	return nCrossing%2 == 1
+	return converted
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	data, start, err := parseData(utils.ReadFile("input.txt"))
 
 	if err != nil {
 		fmt.Printf("Error parsing data: %v", err)
-		return
+		return utils.Answer{}
 	}
 
 	var distance int
 	distance, err = largestDistance(data, start)
 	fmt.Printf("Largest distance: %d\n", distance)
+	return utils.NewIntAnswer(distance)
 }
 
-func tilesEnclosed(g grid, polygon []point) int {
-	var enclosed int
-	for y := 0; y < len(g); y++ {
-		for x := 0; x < len(g[y]); x++ {
-
-			contains := slices.ContainsFunc(polygon, func(p point) bool {
-				return p.equal(point{x, y})
-			})
-
-			if contains {
-				continue
-			}
-
-			p := point{x, y}
-
-			if pointInPolygon(p, g, polygon) {
-				enclosed++
-			}
-		}
+// tilesEnclosed is a function that returns the number of tiles enclosed by the polygon.
+func tilesEnclosed(polygon []point) (int, error) {
+	index, err := utils.NewPolygonIndex(toUtilsPath(polygon))
+	if err != nil {
+		return 0, err
 	}
-	return enclosed
+	return index.CountInside(), nil
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	data, start, err := parseData(utils.ReadFile("input2.txt"))
 
 	if err != nil {
 		fmt.Printf("Error parsing data: %v", err)
-		return
+		return utils.Answer{}
 	}
 
 	var polygon []point
@@ -289,14 +244,22 @@ func part2() {
 
 	if err != nil {
 		fmt.Printf("Error finding path: %v", err)
-		return
+		return utils.Answer{}
 	}
 
-	enclosed := tilesEnclosed(data, polygon)
+	var enclosed int
+	enclosed, err = tilesEnclosed(polygon)
+	if err != nil {
+		fmt.Printf("Error counting enclosed tiles: %v", err)
+		return utils.Answer{}
+	}
 
 	fmt.Printf("Tiles enclosed: %d\n", enclosed)
+	return utils.NewIntAnswer(enclosed)
 }
+
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
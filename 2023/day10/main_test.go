@@ -2,6 +2,8 @@ package main
 
 import (
 	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
+	"strings"
 	"testing"
 )
 
@@ -309,88 +311,6 @@ func TestLargestDistance(t *testing.T) {
 	}
 }
 
-func TestPointInPolygon(t *testing.T) {
-
-	type values []struct {
-		p    point
-		want bool
-	}
-
-	testCases := []struct {
-		data   []string
-		values values
-	}{
-		{mockData,
-			values{
-				{point{0, 0}, false},
-				{point{2, 2}, true},
-				{point{4, 1}, false},
-			},
-		},
-		{
-			mockData2,
-			values{
-				{point{0, 0}, false},
-				{point{2, 2}, true},
-			},
-		},
-		{
-			mockData3,
-			values{
-				{point{0, 0}, false},
-				{point{3, 3}, false},
-				{point{5, 5}, false},
-				{point{2, 6}, true},
-				{point{7, 6}, true},
-			},
-		},
-		{
-			mockData4,
-			values{
-				{point{0, 0}, false},
-				{point{3, 2}, false},
-				{point{4, 4}, false},
-				{point{7, 4}, true},
-				{point{6, 6}, true},
-				{point{14, 6}, true},
-				{point{0, 6}, false},
-				{point{9, 8}, false},
-			},
-		},
-		{
-			mockData5,
-			values{
-				{point{10, 4}, true},
-				{point{1, 8}, false},
-				{point{14, 3}, true},
-			},
-		},
-	}
-	for idx, tc := range testCases {
-		data, start, err := parseData(tc.data)
-
-		if err != nil {
-			t.Errorf("Error parsing data: %v", err)
-		}
-
-		var polygon []point
-		polygon, err = path(data, start)
-		if err != nil {
-			t.Errorf("Error finding first step: %v", err)
-		}
-
-		for idx2, v := range tc.values {
-			t.Run(fmt.Sprintf("TC %d.%d", idx, idx2), func(t *testing.T) {
-				got := pointInPolygon(v.p, data, polygon)
-				if got != v.want {
-					t.Errorf("Expected (%v) %v, got %v", v.p, v.want, got)
-				}
-			})
-		}
-	}
-
-}
-
 func TestTilesEnclosed(t *testing.T) {
 	testCases := []struct {
 		data []string
@@ -417,7 +337,10 @@ func TestTilesEnclosed(t *testing.T) {
 			}
 
 			var got int
-			got = tilesEnclosed(data, polygon)
+			got, err = tilesEnclosed(polygon)
+			if err != nil {
+				t.Fatalf("Error counting enclosed tiles: %v", err)
+			}
 
 			if got != tc.want {
 				t.Errorf("Expected %d, got %d", tc.want, got)
@@ -425,3 +348,46 @@ func TestTilesEnclosed(t *testing.T) {
 		})
 	}
 }
+
+func FuzzParseData(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Add(strings.Join(mockData2, "\n"))
+	f.Add(strings.Join(mockData3, "\n"))
+	f.Add(strings.Join(mockData4, "\n"))
+	f.Add(strings.Join(mockData5, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _, _ = parseData(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data, start, err := parseData(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := largestDistance(data, start); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data, start, err := parseData(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		polygon, err := path(data, start)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := tilesEnclosed(polygon); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -8,23 +8,8 @@ import (
 type grid = utils.Grid[string]
 type point = utils.Point
 
-func parseData(data []string) grid {
-	var parsedData grid
-
-	for _, line := range data {
-		var row []string
-
-		if len(line) == 0 {
-			continue
-		}
-
-		for _, char := range line {
-			row = append(row, string(char))
-		}
-		parsedData = append(parsedData, row)
-	}
-
-	return parsedData
+func parseData(data []string) (grid, error) {
+	return utils.ParseGrid(data, utils.Strings)
 }
 
 // rowsColsWithoutGalaxy is a function that returns the rows and columns idx of a grid that have no galaxy.
@@ -66,9 +51,9 @@ func rowsColsWithoutGalaxy(g grid, galaxies []point) (rows, cols []int) {
 
 func getGalaxies(g grid) []point {
 	var galaxies []point
-	for e := range g.Iterator() {
-		if e.Value == "#" {
-			galaxies = append(galaxies, e.Index)
+	for p, value := range g.All() {
+		if value == "#" {
+			galaxies = append(galaxies, p)
 		}
 	}
 	return galaxies
@@ -222,20 +207,30 @@ func expandAndSumAllDistances(g grid) int {
 	return sumAllDistances(g)
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Print("Part 1: ")
-	data := parseData(utils.ReadFile("input.txt"))
+	data, err := parseData(utils.ReadFile("input.txt"))
+	if err != nil {
+		panic(err)
+	}
 	sum := expandAndSumAllDistances(data)
 	fmt.Printf("The sum of all distances is %d\n", sum)
+	return utils.NewIntAnswer(sum)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Print("Part 2: ")
-	data := parseData(utils.ReadFile("input2.txt"))
+	data, err := parseData(utils.ReadFile("input2.txt"))
+	if err != nil {
+		panic(err)
+	}
 	sum := sumDistancesVirtualExpand(data, 1000000)
 	fmt.Printf("The sum of all distances is %d\n", sum)
+	return utils.NewIntAnswer(sum)
 }
+
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -2,7 +2,9 @@ package main
 
 import (
 	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
 	"slices"
+	"strings"
 	"testing"
 )
 
@@ -34,8 +36,18 @@ var mockData2 = []string{
 	"#....#.......",
 }
 
+// mustParseData parses a grid, failing the test on error
+func mustParseData(t *testing.T, input []string) grid {
+	t.Helper()
+	g, err := parseData(input)
+	if err != nil {
+		t.Fatalf("parseData(%v) returned error: %v", input, err)
+	}
+	return g
+}
+
 func TestParseData(t *testing.T) {
-	g := parseData(mockData)
+	g := mustParseData(t, mockData)
 
 	if height := g.Height(); height != 10 {
 		t.Errorf("Height: got %v, want %v", height, 10)
@@ -47,7 +59,7 @@ func TestParseData(t *testing.T) {
 }
 
 func TestGetGalaxies(t *testing.T) {
-	g := parseData(mockData)
+	g := mustParseData(t, mockData)
 	galaxies := getGalaxies(g)
 
 	if len(galaxies) != 9 {
@@ -56,7 +68,7 @@ func TestGetGalaxies(t *testing.T) {
 }
 
 func TestRowColsWithoutGalaxies(t *testing.T) {
-	g := parseData(mockData)
+	g := mustParseData(t, mockData)
 	galaxies := getGalaxies(g)
 
 	rows, cols := rowsColsWithoutGalaxy(g, galaxies)
@@ -73,7 +85,7 @@ func TestRowColsWithoutGalaxies(t *testing.T) {
 }
 
 func TestAppendColumn(t *testing.T) {
-	g := parseData(mockData)
+	g := mustParseData(t, mockData)
 
 	wantValues := []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J"}
 	col := 3
@@ -92,7 +104,7 @@ func TestAppendColumn(t *testing.T) {
 }
 
 func TestAppendRow(t *testing.T) {
-	g := parseData(mockData)
+	g := mustParseData(t, mockData)
 
 	wantValues := []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J"}
 	row := 3
@@ -111,7 +123,7 @@ func TestAppendRow(t *testing.T) {
 }
 
 func TestAllPairs(t *testing.T) {
-	galaxies := getGalaxies(parseData(mockData))
+	galaxies := getGalaxies(mustParseData(t, mockData))
 
 	pairs := allPairs(galaxies)
 
@@ -121,7 +133,7 @@ func TestAllPairs(t *testing.T) {
 }
 
 func TestSumAllDistances(t *testing.T) {
-	g := parseData(mockData2)
+	g := mustParseData(t, mockData2)
 
 	sum := sumAllDistances(g)
 
@@ -131,8 +143,8 @@ func TestSumAllDistances(t *testing.T) {
 }
 
 func TestExpand(t *testing.T) {
-	g := parseData(mockData)
-	want := parseData(mockData2)
+	g := mustParseData(t, mockData)
+	want := mustParseData(t, mockData2)
 
 	g = expand(g)
 
@@ -144,7 +156,7 @@ func TestExpand(t *testing.T) {
 }
 
 func TestExpandAndSumAllDistances(t *testing.T) {
-	g := parseData(mockData)
+	g := mustParseData(t, mockData)
 	sum := expandAndSumAllDistances(g)
 
 	if sum != 374 {
@@ -153,7 +165,7 @@ func TestExpandAndSumAllDistances(t *testing.T) {
 }
 
 func TestDistance(t *testing.T) {
-	g := parseData(mockData)
+	g := mustParseData(t, mockData)
 	rows, cols := rowsColsWithoutGalaxy(g, getGalaxies(g))
 	p1 := point{X: 1, Y: 5}
 	p2 := point{X: 4, Y: 9}
@@ -167,7 +179,7 @@ func TestDistance(t *testing.T) {
 }
 
 func TestSumDistancesVirtualExpand(t *testing.T) {
-	g := parseData(mockData)
+	g := mustParseData(t, mockData)
 
 	testCases := []struct {
 		n    int
@@ -187,3 +199,35 @@ func TestSumDistancesVirtualExpand(t *testing.T) {
 		})
 	}
 }
+
+func FuzzParseData(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Add(strings.Join(mockData2, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parseData(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data, err := parseData(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		expandAndSumAllDistances(data)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data, err := parseData(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumDistancesVirtualExpand(data, 1000000)
+	}
+}
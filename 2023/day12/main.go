@@ -176,7 +176,7 @@ func unfoldAll(records []record, n int) []record {
 	return newRecords
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	records, err := parseData(utils.ReadFile("input.txt"))
 	if err != nil {
@@ -184,9 +184,10 @@ func part1() {
 	}
 	sum := sumOfMatches(records)
 	fmt.Printf("Sum of matches: %d\n", sum)
+	return utils.NewIntAnswer(sum)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	records, err := parseData(utils.ReadFile("input2.txt"))
 	if err != nil {
@@ -195,8 +196,11 @@ func part2() {
 	records = unfoldAll(records, 5)
 	sum := sumOfMatches(records)
 	fmt.Printf("Sum of matches: %d\n", sum)
+	return utils.NewIntAnswer(sum)
 }
+
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
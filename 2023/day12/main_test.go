@@ -2,8 +2,10 @@ package main
 
 import (
 	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
 	"slices"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -227,3 +229,35 @@ func TestSumOfMatchesUnfolded(t *testing.T) {
 		t.Errorf("sumOfMatchesUnfolded() returned %d, expected %d", got, want)
 	}
 }
+
+func FuzzParseData(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parseData(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	records, err := parseData(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumOfMatches(records)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	records, err := parseData(utils.ReadFile("input2.txt"))
+	records = unfoldAll(records, 5)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumOfMatches(records)
+	}
+}
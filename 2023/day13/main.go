@@ -4,25 +4,19 @@ import (
 	"errors"
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
+	parseutil "github.com/iamlucasvieira/aoc/utils/parse"
 	"strings"
 )
 
 // parse takes a slice of strings and returns a slice of patterns.
 func parse(input []string) ([][][]string, error) {
 	var patterns [][][]string
-	var pattern [][]string
 
-	for _, line := range input {
-		if line == "" {
-			patterns = append(patterns, pattern)
-			pattern = [][]string{}
-			continue
+	for _, section := range parseutil.Sections(input) {
+		var pattern [][]string
+		for _, line := range section {
+			pattern = append(pattern, strings.Split(line, ""))
 		}
-
-		pattern = append(pattern, strings.Split(line, ""))
-	}
-
-	if len(pattern) > 0 {
 		patterns = append(patterns, pattern)
 	}
 
@@ -80,17 +74,12 @@ func nonReflectivePoints(s []string, x int) (int, error) {
 
 // getColumn returns column idx as a row.
 func getColumn(s [][]string, idx int) []string {
-	var row = make([]string, len(s))
-
-	for i := range row {
-		row[i] = s[i][idx]
-	}
-	return row
+	return utils.Grid[string](s).Col(idx)
 }
 
 // getRow returns row idx as a column.
 func getRow(s [][]string, idx int) []string {
-	return s[idx]
+	return utils.Grid[string](s).Row(idx)
 }
 
 // possibleReflections returns a slice of possible reflections for a pattern.
@@ -221,7 +210,7 @@ func sumScoreFixing(p [][][]string) int {
 	return sumScoreTemplate(p, patternScoreFixing)
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	data, err := parse(utils.ReadFile("input.txt"))
 
@@ -231,9 +220,10 @@ func part1() {
 
 	score := sumScore(data)
 	fmt.Printf("Score: %v\n", score)
+	return utils.NewIntAnswer(score)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	data, err := parse(utils.ReadFile("input2.txt"))
 
@@ -243,9 +233,11 @@ func part2() {
 
 	score := sumScoreFixing(data)
 	fmt.Printf("Score: %v\n", score)
+	return utils.NewIntAnswer(score)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
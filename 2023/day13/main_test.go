@@ -2,7 +2,9 @@ package main
 
 import (
 	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
 	"slices"
+	"strings"
 	"testing"
 )
 
@@ -362,3 +364,34 @@ func TestSumScoreFixing(t *testing.T) {
 		t.Errorf("Expected %v, got %v", want, got)
 	}
 }
+
+func FuzzParse(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parse(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data, err := parse(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumScore(data)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data, err := parse(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumScoreFixing(data)
+	}
+}
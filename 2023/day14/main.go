@@ -3,30 +3,41 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/render"
+	"image/color"
 	"strings"
 )
 
 // parse parses the input and returns slices for each column.
-func parse(input []string) []string {
-	var columns = make([][]string, len(input))
-	var columnsStrings = make([]string, len(input))
+func parse(input []string) ([]string, error) {
+	var columns [][]string
 	for _, line := range input {
 		if len(line) == 0 {
 			continue
 		}
 		data := strings.Split(line, "")
 
+		// The first row defines the number of columns
+		if columns == nil {
+			columns = make([][]string, len(data))
+		}
+
+		if len(data) != len(columns) {
+			return nil, fmt.Errorf("row %q has %d columns, expected %d", line, len(data), len(columns))
+		}
+
 		for i, c := range data {
 			columns[i] = append(columns[i], c)
 		}
 	}
 
-	// Transform each row into a string
+	// Transform each column into a string
+	var columnsStrings = make([]string, len(columns))
 	for i, column := range columns {
 		columnsStrings[i] = strings.Join(column, "")
 	}
 
-	return columnsStrings
+	return columnsStrings, nil
 }
 
 type cache map[string]string
@@ -34,18 +45,9 @@ type cache map[string]string
 // table is a slice of slices of strings.
 type table [][]string
 
-// Row returns a string with the row at index i.
-func (t table) Row(i int) string {
-	return strings.Join(t[i], "")
-}
-
 // Column returns a string with the column at index i.
 func (t table) Column(i int) string {
-	var column []string
-	for _, row := range t {
-		column = append(column, row[i])
-	}
-	return strings.Join(column, "")
+	return strings.Join(utils.Grid[string](t).Col(i), "")
 }
 
 // replaceColumn replaces the column at index i with the string s.
@@ -55,11 +57,6 @@ func (t table) replaceColumn(i int, s string) {
 	}
 }
 
-// replaceRow replaces the row at index i with the string s.
-func (t table) replaceRow(i int, s string) {
-	t[i] = strings.Split(s, "")
-}
-
 // score returns the score of the table.
 // Each O is worth the height it is at.
 func (t table) score() int {
@@ -82,18 +79,22 @@ func (t table) String() string {
 	return result
 }
 
-// parseAsTable parses the input and returns a table.
-func parseAsTable(input []string) table {
-	var columns [][]string
-	for _, line := range input {
-		if len(line) == 0 {
-			continue
-		}
-		data := strings.Split(line, "")
-		columns = append(columns, data)
+// rockColor returns the colour a tile is rendered with.
+func rockColor(s string) color.Color {
+	switch s {
+	case "O":
+		return color.RGBA{R: 230, G: 160, B: 40, A: 255}
+	case "#":
+		return color.RGBA{R: 110, G: 110, B: 110, A: 255}
+	default:
+		return color.RGBA{R: 20, G: 20, B: 30, A: 255}
 	}
+}
 
-	return columns
+// parseAsTable parses the input and returns a table.
+func parseAsTable(input []string) (table, error) {
+	g, err := utils.ParseGrid(input, utils.Strings)
+	return table(g), err
 }
 
 // rocksIndex returns the index of all rocks in a column.
@@ -150,67 +151,48 @@ func moveLeft(s string) string {
 	return s
 }
 
-// Moves all 0s to the right
-func moveRight(s string) string {
-	// Reverse string
-	s = reverseString(s)
-	// Move left
-	s = moveLeft(s)
-	// Reverse again
-	s = reverseString(s)
-	return s
-}
-
-func reverseString(s string) string {
-	var result string
-	for i := len(s) - 1; i >= 0; i-- {
-		result += string(s[i])
-	}
-	return result
-}
-
-func moveTableHorizontal(t table, c cache, moveFunc func(string) string, symbol string) table {
-	for i := range t {
-		row := t.Row(i)
-		newRow, ok := c[row+symbol]
+// moveTableUp moves all rocks of the table up.
+func moveTableUp(t table, c cache) table {
+	for i := 0; i < len(t[0]); i++ {
+		column := t.Column(i)
+		newColumn, ok := c[column]
 
 		if !ok {
-			newRow = moveFunc(row)
-			c[row+symbol] = newRow
+			newColumn = moveLeft(column)
+			c[column] = newColumn
 		}
-		t.replaceRow(i, newRow)
+		t.replaceColumn(i, newColumn)
 	}
 	return t
 }
 
-func moveTableRight(t table, c cache) table {
-	return moveTableHorizontal(t, c, moveRight, ">")
-}
+// moveTableRotated moves all rocks up in the table rotated clockwise turns times, and rotates it back.
+// This way only moving up is implemented, and the other directions are a rotation of it.
+func moveTableRotated(t table, c cache, turns int) table {
+	g := utils.Grid[string](t)
+	for i := 0; i < turns; i++ {
+		g = g.RotateCW()
+	}
+	g = utils.Grid[string](moveTableUp(table(g), c))
+	for i := 0; i < turns; i++ {
+		g = g.RotateCCW()
+	}
 
-func moveTableLeft(t table, c cache) table {
-	return moveTableHorizontal(t, c, moveLeft, "<")
+	// Write the rows back, as tables are moved in place
+	copy(t, g)
+	return t
 }
 
-func moveTableVertical(t table, c cache, moveFunc func(string) string, symbol string) table {
-	for i := 0; i < len(t[0]); i++ {
-		column := t.Column(i)
-		newColumn, ok := c[column+symbol]
-
-		if !ok {
-			newColumn = moveFunc(column)
-			c[column+symbol] = newColumn
-		}
-		t.replaceColumn(i, newColumn)
-	}
-	return t
+func moveTableLeft(t table, c cache) table {
+	return moveTableRotated(t, c, 1)
 }
 
 func moveTableDown(t table, c cache) table {
-	return moveTableVertical(t, c, moveRight, ">")
+	return moveTableRotated(t, c, 2)
 }
 
-func moveTableUp(t table, c cache) table {
-	return moveTableVertical(t, c, moveLeft, "<")
+func moveTableRight(t table, c cache) table {
+	return moveTableRotated(t, c, 3)
 }
 
 // Move table Up, Left, Down and Right
@@ -222,61 +204,79 @@ func cycle(t table, c cache) table {
 	return t
 }
 
-func cycleNTimes(t table, c cache, n int) table {
-
-	type cachedTable struct {
-		t      table
-		cycles int
+// cycleNTimes returns the table after cycling it n times, skipping ahead once the tables repeat.
+// onCycle, if not nil, is called with the table after each cycle on the way to the result.
+func cycleNTimes(t table, c cache, n int, onCycle func(table)) table {
+	// Tables are cycled in place, so each step cycles a copy
+	next := func(t table) table {
+		return cycle(table(utils.Grid[string](t).Clone()), c)
+	}
+	key := func(t table) string {
+		return t.String()
 	}
-	var cyclesCache = make(map[string]cachedTable)
-
-	for i := 0; i < n; i++ {
-
-		tableRows, ok := cyclesCache[t.String()]
-
-		if ok {
-			t = tableRows.t
-
-			// If we found a cycle, we can skip the rest of the iterations
-
-			// Cycle length
-			cycleLength := i - tableRows.cycles
-
-			// Cycles left
-			cyclesLeft := n - i - 1
-
-			// How many times we can skip the cycle
-			skipCycles := cyclesLeft / cycleLength
 
-			// Skip the cycles
-			if cyclesLeft%cycleLength == 0 {
-				i += skipCycles * cycleLength
-			}
+	found := utils.FindCycle(t, next, key)
 
+	return utils.StateAt(t, func(t table) table {
+		t = next(t)
+		if onCycle != nil {
+			onCycle(t)
 		}
-		currentT := t.String()
-		t = cycle(t, c)
-		cyclesCache[currentT] = cachedTable{t, i}
-
-	}
-	return t
+		return t
+	}, found, n)
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
-	data := parse(utils.ReadFile("input.txt"))
+	data, err := parse(utils.ReadFile("input.txt"))
+	if err != nil {
+		panic(err)
+	}
 	impact := allImpact(data)
 	fmt.Printf("The impact is %d\n", impact)
+	return utils.NewIntAnswer(impact)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
-	data := parseAsTable(utils.ReadFile("input2.txt"))
+	data, err := parseAsTable(utils.ReadFile("input2.txt"))
+	if err != nil {
+		panic(err)
+	}
 	c := make(cache)
-	result := cycleNTimes(data, c, 1000000000)
-	fmt.Printf("The impact is %d\n", result.score())
+
+	var onCycle func(table)
+	path, toRender := render.OutputPath("part2")
+	anim := render.NewAnimation(rockColor, 4, 10)
+	terminal, toDraw := render.NewLiveTerminal(func(s string) rune { return rune(s[0]) })
+	if toRender || toDraw {
+		onCycle = func(t table) {
+			if toRender {
+				anim.Add(utils.Grid[string](t))
+			}
+			if toDraw {
+				if err := terminal.Draw(utils.Grid[string](t)); err != nil {
+					panic(err)
+				}
+			}
+		}
+	}
+
+	result := cycleNTimes(data, c, 1000000000, onCycle)
+	impact := result.score()
+	fmt.Printf("The impact is %d\n", impact)
+
+	if toRender {
+		if err := anim.Save(path); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Rendered %d cycles to %s\n", anim.Len(), path)
+	}
+	return utils.NewIntAnswer(impact)
 }
+
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -1,7 +1,10 @@
 package main
 
 import (
+	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
 	"slices"
+	"strings"
 	"testing"
 )
 
@@ -70,8 +73,21 @@ var mockDataCycle3 = []string{
 	"#.OOO#...O",
 }
 
+// mustParseAsTable parses a table, failing the test on error
+func mustParseAsTable(t *testing.T, input []string) table {
+	t.Helper()
+	data, err := parseAsTable(input)
+	if err != nil {
+		t.Fatalf("parseAsTable(%v) returned error: %v", input, err)
+	}
+	return data
+}
+
 func TestParse(t *testing.T) {
-	data := parse(mockData)
+	data, err := parse(mockData)
+	if err != nil {
+		t.Fatalf("parse(mockData) returned error: %v", err)
+	}
 
 	if len(data) != 10 {
 		t.Errorf("Expected 10 columns, got %d", len(data))
@@ -79,7 +95,10 @@ func TestParse(t *testing.T) {
 }
 
 func TestRocksIndex(t *testing.T) {
-	data := parse(mockData)
+	data, err := parse(mockData)
+	if err != nil {
+		t.Fatalf("parse(mockData) returned error: %v", err)
+	}
 
 	testCases := []struct {
 		index    int
@@ -107,7 +126,10 @@ func TestRocksIndex(t *testing.T) {
 }
 
 func TestRocksImpactColumn(t *testing.T) {
-	data := parse(mockData)
+	data, err := parse(mockData)
+	if err != nil {
+		t.Fatalf("parse(mockData) returned error: %v", err)
+	}
 
 	testCases := []struct {
 		index    int
@@ -137,7 +159,10 @@ func TestRocksImpactColumn(t *testing.T) {
 }
 
 func TestAllImpact(t *testing.T) {
-	data := parse(mockData)
+	data, err := parse(mockData)
+	if err != nil {
+		t.Fatalf("parse(mockData) returned error: %v", err)
+	}
 
 	result := allImpact(data)
 	expected := 136
@@ -148,7 +173,7 @@ func TestAllImpact(t *testing.T) {
 }
 
 func TestParseTable(t *testing.T) {
-	data := parseAsTable(mockData)
+	data := mustParseAsTable(t, mockData)
 
 	if len(data) != 10 {
 		t.Errorf("Expected 10 columns, got %d", len(data))
@@ -160,7 +185,7 @@ func TestParseTable(t *testing.T) {
 }
 
 func TestTable_Column(t *testing.T) {
-	data := parseAsTable(mockData)
+	data := mustParseAsTable(t, mockData)
 
 	testCases := []struct {
 		index    int
@@ -181,49 +206,8 @@ func TestTable_Column(t *testing.T) {
 	}
 }
 
-func TestTable_Row(t *testing.T) {
-	data := parseAsTable(mockData)
-
-	testCases := []struct {
-		index    int
-		expected string
-	}{
-		{0, "O....#...."},
-		{1, "O.OO#....#"},
-		{2, ".....##..."},
-		{3, "OO.#O....O"},
-		{4, ".O.....O#."},
-		{5, "O.#..O.#.#"},
-		{6, "..O..#O..O"},
-		{7, ".......O.."},
-		{8, "#....###.."},
-		{9, "#OO..#...."},
-	}
-
-	for _, tc := range testCases {
-		t.Run(data.Row(tc.index), func(t *testing.T) {
-			result := data.Row(tc.index)
-
-			if result != tc.expected {
-				t.Errorf("idx: %v, Expected %s, got %s", tc.index, tc.expected, result)
-			}
-		})
-	}
-
-}
-
-func TestTable_ReplaceRow(t *testing.T) {
-	data := parseAsTable(mockData)
-
-	data.replaceRow(0, "OOOOOOOOOO")
-
-	if data.Row(0) != "OOOOOOOOOO" {
-		t.Errorf("Expected OOOOOOOOOO, got %s", data.Row(0))
-	}
-}
-
 func TestTable_ReplaceColumn(t *testing.T) {
-	data := parseAsTable(mockData)
+	data := mustParseAsTable(t, mockData)
 
 	data.replaceColumn(0, "OOOOOOOOOO")
 
@@ -255,51 +239,6 @@ func TestMoveLeft(t *testing.T) {
 	}
 }
 
-func TestMoveRight(t *testing.T) {
-
-	testCases := []struct {
-		data string
-		want string
-	}{
-		{"..O..#O..O", "....O#..OO"},
-		{"........O", "........O"},
-		{"O.O......", ".......OO"},
-		{"O.O.O.O.O", "....OOOOO"},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.data, func(t *testing.T) {
-			result := moveRight(tc.data)
-
-			if result != tc.want {
-				t.Errorf("Expected %s, got %s", tc.want, result)
-			}
-		})
-	}
-}
-
-func TestReverseString(t *testing.T) {
-	testCases := []struct {
-		data string
-		want string
-	}{
-		{"..O..#O..O", "O..O#..O.."},
-		{"........O", "O........"},
-		{"O.O......", "......O.O"},
-		{"O.O.O.O.O", "O.O.O.O.O"},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.data, func(t *testing.T) {
-			result := reverseString(tc.data)
-
-			if result != tc.want {
-				t.Errorf("Expected %s, got %s", tc.want, result)
-			}
-		})
-	}
-}
-
 func TestMoveTableRight(t *testing.T) {
 	data := [][]string{
 		{"O", ".", "."},
@@ -371,8 +310,8 @@ func TestMoveTableDown(t *testing.T) {
 }
 
 func TestMoveTableUp(t *testing.T) {
-	data := parseAsTable(mockData)
-	want := parseAsTable(mockDataUp)
+	data := mustParseAsTable(t, mockData)
+	want := mustParseAsTable(t, mockDataUp)
 
 	c := make(cache)
 	result := moveTableUp(data, c)
@@ -385,7 +324,7 @@ func TestMoveTableUp(t *testing.T) {
 }
 
 func TestMoveAndScore(t *testing.T) {
-	data := parseAsTable(mockData)
+	data := mustParseAsTable(t, mockData)
 
 	c := make(cache)
 	result := moveTableUp(data, c)
@@ -425,14 +364,14 @@ func TestCycleNTimes(t *testing.T) {
 		data [][]string
 		want [][]string
 	}{
-		{1, parseAsTable(mockData), parseAsTable(mockDataCycle1)},
-		{2, parseAsTable(mockData), parseAsTable(mockDataCycle2)},
-		{3, parseAsTable(mockData), parseAsTable(mockDataCycle3)},
+		{1, mustParseAsTable(t, mockData), mustParseAsTable(t, mockDataCycle1)},
+		{2, mustParseAsTable(t, mockData), mustParseAsTable(t, mockDataCycle2)},
+		{3, mustParseAsTable(t, mockData), mustParseAsTable(t, mockDataCycle3)},
 	}
 
 	for _, tc := range testCases {
 		c := make(cache)
-		result := cycleNTimes(tc.data, c, tc.n)
+		result := cycleNTimes(tc.data, c, tc.n, nil)
 
 		for i := range result {
 			if !slices.Equal(result[i], tc.want[i]) {
@@ -442,14 +381,61 @@ func TestCycleNTimes(t *testing.T) {
 	}
 }
 
+func TestCycleNTimesSkipping(t *testing.T) {
+	// Skipping ahead must give the same table as cycling every time, whatever the remainder of the period
+	want := mustParseAsTable(t, mockData)
+	for n := 1; n <= 30; n++ {
+		want = cycle(want, make(cache))
+
+		t.Run(fmt.Sprintf("%d", n), func(t *testing.T) {
+			got := cycleNTimes(mustParseAsTable(t, mockData), make(cache), n, nil)
+			if got.String() != want.String() {
+				t.Errorf("Expected %v, got %v", want, got)
+			}
+		})
+	}
+}
+
 func TestCycleAndScore(t *testing.T) {
-	data := parseAsTable(mockData)
+	data := mustParseAsTable(t, mockData)
 
 	c := make(cache)
-	result := cycleNTimes(data, c, 1000)
+	result := cycleNTimes(data, c, 1000, nil)
 	score := result.score()
 
 	if score != 64 {
 		t.Errorf("Expected 64, got %v", score)
 	}
 }
+
+func FuzzParse(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parse(strings.Split(input, "\n"))
+		_, _ = parseAsTable(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data, err := parse(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		allImpact(data)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data, err := parseAsTable(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cycleNTimes(data, make(cache), 1000000000, nil).score()
+	}
+}
@@ -19,15 +19,19 @@ func parse(lines []string) []string {
 	return strings.Split(singleString, ",")
 }
 
-func parseLenses(lines []string) []lens {
+// parseLenses takes a slice of strings and returns the lenses and their operations
+func parseLenses(lines []string) ([]lens, error) {
 	var lenses []lens
 	for _, l := range parse(lines) {
 		if strings.Contains(l, "=") {
 			split := strings.Split(l, "=")
+			if len(split) != 2 {
+				return nil, fmt.Errorf("invalid lens: %q", l)
+			}
 			label := split[0]
 			focal, err := strconv.Atoi(split[1])
 			if err != nil {
-				panic(err)
+				return nil, fmt.Errorf("invalid focal length in %q: %v", l, err)
 			}
 			lenses = append(lenses, lens{label, focal, "="})
 		} else {
@@ -36,7 +40,7 @@ func parseLenses(lines []string) []lens {
 			lenses = append(lenses, lens{label, -1, "-"})
 		}
 	}
-	return lenses
+	return lenses, nil
 }
 
 // hash takes a string and returns an int
@@ -122,22 +126,29 @@ func score(b boxes) int {
 	return sum
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	data := parse(utils.ReadFile("input.txt"))
 	sum := hashSum(data)
 	fmt.Printf("The sum of the hash of each string is %d\n", sum)
+	return utils.NewIntAnswer(sum)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
-	lenses := parseLenses(utils.ReadFile("input2.txt"))
+	lenses, err := parseLenses(utils.ReadFile("input2.txt"))
+	if err != nil {
+		panic(err)
+	}
 	b := make(boxes)
 	processLenses(b, lenses)
-	fmt.Printf("The score of the boxes is %d\n", score(b))
+	s := score(b)
+	fmt.Printf("The score of the boxes is %d\n", s)
+	return utils.NewIntAnswer(s)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
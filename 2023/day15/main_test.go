@@ -1,6 +1,10 @@
 package main
 
-import "testing"
+import (
+	"github.com/iamlucasvieira/aoc/utils"
+	"strings"
+	"testing"
+)
 
 var mockData = []string{
 	"rn=1,cm-,qp=3,cm=2,qp-,pc",
@@ -59,7 +63,10 @@ func TestHashSum(t *testing.T) {
 }
 
 func TestParseLenses(t *testing.T) {
-	lenses := parseLenses(mockData)
+	lenses, err := parseLenses(mockData)
+	if err != nil {
+		t.Fatalf("parseLenses(mockData) returned error: %v", err)
+	}
 
 	if len(lenses) != 11 {
 		t.Errorf("Expected 11, got %d", len(lenses))
@@ -129,7 +136,10 @@ func TestRemoveLens(t *testing.T) {
 }
 
 func TestProcessLenses(t *testing.T) {
-	data := parseLenses(mockData)
+	data, err := parseLenses(mockData)
+	if err != nil {
+		t.Fatalf("parseLenses(mockData) returned error: %v", err)
+	}
 
 	b := make(boxes)
 
@@ -169,7 +179,10 @@ func TestProcessLenses(t *testing.T) {
 }
 
 func TestScore(t *testing.T) {
-	data := parseLenses(mockData)
+	data, err := parseLenses(mockData)
+	if err != nil {
+		t.Fatalf("parseLenses(mockData) returned error: %v", err)
+	}
 
 	b := make(boxes)
 
@@ -182,3 +195,33 @@ func TestScore(t *testing.T) {
 		t.Errorf("Expected %d, got %d", expected, actual)
 	}
 }
+
+func FuzzParseLenses(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parseLenses(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data := parse(utils.ReadFile("input.txt"))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hashSum(data)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	lenses, err := parseLenses(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		boxes := make(boxes)
+		processLenses(boxes, lenses)
+		score(boxes)
+	}
+}
@@ -3,19 +3,15 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/render"
+	"image/color"
+	"strings"
 )
 
 type grid = utils.Grid[*tile]
 type point = utils.Point
 type cache = map[string]int
 
-var (
-	left  = point{X: -1}
-	right = point{X: 1}
-	up    = point{Y: -1}
-	down  = point{Y: 1}
-)
-
 type tile struct {
 	kind      rune
 	energised bool
@@ -24,24 +20,40 @@ type tile struct {
 // nEnergised returns the number of energised tiles in the grid
 func nEnergised(g grid) int {
 	var count int
-	for item := range g.Iterator() {
-		if item.Value.energised {
+	for _, t := range g.All() {
+		if t.energised {
 			count++
 		}
 	}
 	return count
 }
 
+// tileColor returns the colour a tile is rendered with, energised tiles are highlighted.
+func tileColor(t *tile) color.Color {
+	switch {
+	case t.energised:
+		return color.RGBA{R: 250, G: 210, B: 60, A: 255}
+	case t.kind != '.':
+		return color.RGBA{R: 90, G: 120, B: 200, A: 255}
+	default:
+		return color.RGBA{R: 20, G: 20, B: 30, A: 255}
+	}
+}
+
+// tileRune returns the character a tile is shown with, energised tiles are shown as #.
+func tileRune(t *tile) rune {
+	if t.energised {
+		return '#'
+	}
+	return t.kind
+}
+
 func energyString(g grid) string {
 	var output string
 
 	for _, row := range g {
 		for _, item := range row {
-			if item.energised {
-				output += "#"
-			} else {
-				output += string(item.kind)
-			}
+			output += string(tileRune(item))
 		}
 		output += "\n"
 	}
@@ -49,22 +61,18 @@ func energyString(g grid) string {
 }
 
 // parse returns the matrix with the problem grid
-func parse(input []string) grid {
-	var output [][]*tile
-	for _, line := range input {
-		if len(line) == 0 {
-			continue
+func parse(input []string) (grid, error) {
+	return utils.ParseGrid(input, func(r rune, _ point) (*tile, error) {
+		if !strings.ContainsRune(`./\|-`, r) {
+			return nil, fmt.Errorf("invalid tile %q", r)
 		}
-		var row = make([]*tile, 0, len(line))
-		for _, char := range line {
-			row = append(row, &tile{char, false})
-		}
-		output = append(output, row)
-	}
-	return output
+		return &tile{r, false}, nil
+	})
 }
 
-func moveBeam(start, direction point, g grid, c cache) {
+// moveBeam energises the tiles a beam goes through from start, splitting it on the splitters it meets.
+// onStep, if not nil, is called with each tile the beam moves to.
+func moveBeam(start point, direction utils.Direction, g grid, c cache, onStep func(point)) {
 
 	current := start
 
@@ -81,50 +89,34 @@ func moveBeam(start, direction point, g grid, c cache) {
 		}
 		c[current.String()+direction.String()] = 1
 
-		nextPoint := current.Add(direction)
+		nextPoint := current.Move(direction)
 		if !g.Contains(nextPoint) {
 			return
 		}
 		nextPointValue := g.Get(nextPoint)
 		nextPointValue.energised = true
+
+		if onStep != nil {
+			onStep(nextPoint)
+		}
 		switch nextPointValue.kind {
 		case '.':
 		case '|':
-			if direction == left || direction == right {
+			if direction.IsHorizontal() {
 				// Split the beam into two moving in opposite directions from the next point
-				moveBeam(nextPoint, up, g, c)
-				moveBeam(nextPoint, down, g, c)
+				moveBeam(nextPoint, utils.Up, g, c, onStep)
+				moveBeam(nextPoint, utils.Down, g, c, onStep)
 				return
 			}
 		case '-':
-			if direction == up || direction == down {
+			if direction.IsVertical() {
 				// Split the beam into two moving in opposite directions from the next point
-				moveBeam(nextPoint, left, g, c)
-				moveBeam(nextPoint, right, g, c)
+				moveBeam(nextPoint, utils.Left, g, c, onStep)
+				moveBeam(nextPoint, utils.Right, g, c, onStep)
 				return
 			}
-		case '/':
-			switch direction {
-			case up:
-				direction = right
-			case right:
-				direction = up
-			case down:
-				direction = left
-			case left:
-				direction = down
-			}
-		case '\\':
-			switch direction {
-			case up:
-				direction = left
-			case left:
-				direction = up
-			case down:
-				direction = right
-			case right:
-				direction = down
-			}
+		case '/', '\\':
+			direction = direction.Reflect(nextPointValue.kind)
 		}
 		current = nextPoint
 	}
@@ -136,52 +128,93 @@ func moveBeam(start, direction point, g grid, c cache) {
 // Last row: heading up
 // First column: heading right
 // Last column: heading left
-func maxEnergy(d []string) int {
+func maxEnergy(d []string) (int, error) {
 	var maxEnergy int
-	table := parse(d)
+	table, err := parse(d)
+	if err != nil {
+		return 0, err
+	}
 	width := table.Width()
 	height := table.Height()
 
-	var move = func(start point, direction, nextPoint point, maxI int) {
+	var move = func(start point, direction utils.Direction, nextPoint point, maxI int) error {
 		for i := 0; i < maxI; i++ {
-			g := parse(d)
+			g, err := parse(d)
+			if err != nil {
+				return err
+			}
 			startPoint := start.Add(nextPoint.Mul(point{X: i, Y: i}))
-			moveBeam(startPoint, direction, g, make(cache))
+			moveBeam(startPoint, direction, g, make(cache), nil)
 			maxEnergy = max(maxEnergy, nEnergised(g))
 		}
-
+		return nil
 	}
 	// First row
-	move(point{}, down, point{X: 1}, width)
+	if err := move(point{}, utils.Down, point{X: 1}, width); err != nil {
+		return 0, err
+	}
 
 	// Last row
-	move(point{Y: height - 1}, up, point{X: 1}, width)
+	if err := move(point{Y: height - 1}, utils.Up, point{X: 1}, width); err != nil {
+		return 0, err
+	}
 
 	// First column
-	move(point{}, right, point{Y: 1}, height)
+	if err := move(point{}, utils.Right, point{Y: 1}, height); err != nil {
+		return 0, err
+	}
 
 	// Last column
-	move(point{X: width - 1}, left, point{Y: 1}, height)
+	if err := move(point{X: width - 1}, utils.Left, point{Y: 1}, height); err != nil {
+		return 0, err
+	}
 
-	return maxEnergy
+	return maxEnergy, nil
 }
 
-func part1() {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
-	g := parse(utils.ReadFile("input.txt"))
-	moveBeam(point{X: 0, Y: 0}, right, g, make(cache))
+	g, err := parse(utils.ReadFile("input.txt"))
+	if err != nil {
+		panic(err)
+	}
+
+	var onStep func(point)
+	if terminal, ok := render.NewLiveTerminal(tileRune); ok {
+		onStep = func(p point) {
+			if err := terminal.Draw(g, p); err != nil {
+				panic(err)
+			}
+		}
+	}
+	moveBeam(point{X: 0, Y: 0}, utils.Right, g, make(cache), onStep)
 	n := nEnergised(g)
 	fmt.Printf("Number of energised tiles: %d\n", n)
+
+	if path, ok := render.OutputPath("part1"); ok {
+		anim := render.NewAnimation(tileColor, 4, 0)
+		anim.Add(g)
+		if err := anim.Save(path); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Rendered energised tiles to %s\n", path)
+	}
+	return utils.NewIntAnswer(n)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	d := utils.ReadFile("input2.txt")
-	n := maxEnergy(d)
+	n, err := maxEnergy(d)
+	if err != nil {
+		panic(err)
+	}
 	fmt.Printf("Maximum energy: %d\n", n)
+	return utils.NewIntAnswer(n)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
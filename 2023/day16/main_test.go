@@ -2,6 +2,9 @@ package main
 
 import (
 	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -18,9 +21,19 @@ var mockData = []string{
 	"..//.|....",
 }
 
+// mustParse parses a grid, failing the test on error
+func mustParse(t *testing.T, input []string) grid {
+	t.Helper()
+	g, err := parse(input)
+	if err != nil {
+		t.Fatalf("parse(%v) returned error: %v", input, err)
+	}
+	return g
+}
+
 func TestParse(t *testing.T) {
 
-	actual := parse(mockData)
+	actual := mustParse(t, mockData)
 
 	if len(actual) != 10 {
 		t.Errorf("Expected 10, got %d", len(actual))
@@ -54,15 +67,15 @@ func TestMoveBeamAllEnergised(t *testing.T) {
 
 	testCases := []struct {
 		g         grid
-		direction point
+		direction utils.Direction
 	}{
-		{parse(g1), right},
-		{parse(g2), down},
+		{mustParse(t, g1), utils.Right},
+		{mustParse(t, g2), utils.Down},
 	}
 
 	for _, tc := range testCases {
 		t.Run(fmt.Sprintf("%v", tc.g), func(t *testing.T) {
-			moveBeam(point{}, tc.direction, tc.g, make(cache))
+			moveBeam(point{}, tc.direction, tc.g, make(cache), nil)
 			for item := range tc.g.Iterator() {
 				if !item.Value.energised {
 					t.Errorf("Expected true, got %v", item.Value.energised)
@@ -89,16 +102,16 @@ func TestNEnergised(t *testing.T) {
 
 	testCases := []struct {
 		g         grid
-		direction point
+		direction utils.Direction
 		want      int
 	}{
-		{parse(g1), right, 10},
-		{parse(g2), down, 7},
+		{mustParse(t, g1), utils.Right, 10},
+		{mustParse(t, g2), utils.Down, 7},
 	}
 
 	for _, tc := range testCases {
 		t.Run(fmt.Sprintf("%v", tc.g), func(t *testing.T) {
-			moveBeam(point{}, tc.direction, tc.g, make(cache))
+			moveBeam(point{}, tc.direction, tc.g, make(cache), nil)
 			got := nEnergised(tc.g)
 			if got != tc.want {
 				t.Errorf("Expected %d, got %d", tc.want, got)
@@ -114,7 +127,7 @@ func TestMoveBeamSplitVertical(t *testing.T) {
 		"..........",
 	}
 
-	g := parse(g1)
+	g := mustParse(t, g1)
 	wantEnergised := []point{
 		{X: 0, Y: 1},
 		{X: 1, Y: 1},
@@ -130,7 +143,7 @@ func TestMoveBeamSplitVertical(t *testing.T) {
 		{X: 9, Y: 2},
 	}
 
-	moveBeam(point{X: 0, Y: 1}, right, g, make(cache))
+	moveBeam(point{X: 0, Y: 1}, utils.Right, g, make(cache), nil)
 
 	// Check if only the expected points are energised
 	for _, p := range wantEnergised {
@@ -151,7 +164,7 @@ func TestMoveBeamSplitHorizontal(t *testing.T) {
 		"..........",
 	}
 
-	g := parse(g1)
+	g := mustParse(t, g1)
 	wantEnergised := []point{
 		{X: 0, Y: 0},
 		{X: 1, Y: 0},
@@ -171,7 +184,7 @@ func TestMoveBeamSplitHorizontal(t *testing.T) {
 		{X: 9, Y: 1},
 	}
 
-	moveBeam(point{X: 0, Y: 0}, right, g, make(cache))
+	moveBeam(point{X: 0, Y: 0}, utils.Right, g, make(cache), nil)
 
 	for _, p := range wantEnergised {
 		if !g.Get(p).energised {
@@ -191,20 +204,34 @@ func TestMoveBeamSplitDiagonal(t *testing.T) {
 		"\\.........",
 	}
 
-	g := parse(g1)
+	g := mustParse(t, g1)
 	want := len(g1) * len(g1[0])
 
-	moveBeam(point{X: 0, Y: 0}, right, g, make(cache))
+	moveBeam(point{X: 0, Y: 0}, utils.Right, g, make(cache), nil)
 
 	if nEnergised(g) != want {
 		t.Errorf("Expected %d, got %d", want, nEnergised(g))
 	}
 }
 
+func TestMoveBeamOnStep(t *testing.T) {
+	g := mustParse(t, []string{"..|.."})
+
+	var steps []point
+	moveBeam(point{X: 0, Y: 0}, utils.Right, g, make(cache), func(p point) {
+		steps = append(steps, p)
+	})
+
+	want := []point{{X: 1, Y: 0}, {X: 2, Y: 0}}
+	if !slices.Equal(steps, want) {
+		t.Errorf("Expected steps %v, got %v", want, steps)
+	}
+}
+
 func TestMoveBeam(t *testing.T) {
-	g := parse(mockData)
+	g := mustParse(t, mockData)
 
-	moveBeam(point{X: 0, Y: 0}, right, g, make(cache))
+	moveBeam(point{X: 0, Y: 0}, utils.Right, g, make(cache), nil)
 	want := 46
 	fmt.Println(energyString(g))
 	if nEnergised(g) != want {
@@ -214,9 +241,48 @@ func TestMoveBeam(t *testing.T) {
 }
 
 func TestMaxEnergy(t *testing.T) {
-	value := maxEnergy(mockData)
+	value, err := maxEnergy(mockData)
+	if err != nil {
+		t.Fatalf("maxEnergy(mockData) returned error: %v", err)
+	}
 	want := 51
 	if value != want {
 		t.Errorf("Expected %d, got %d", want, value)
 	}
 }
+
+func FuzzParse(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parse(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data := utils.ReadFile("input.txt")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// moveBeam energises the tiles of the grid, so each run starts from a freshly parsed grid
+		b.StopTimer()
+		g, err := parse(data)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+
+		moveBeam(point{X: 0, Y: 0}, utils.Right, g, make(cache), nil)
+		nEnergised(g)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data := utils.ReadFile("input2.txt")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := maxEnergy(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
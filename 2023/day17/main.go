@@ -3,7 +3,8 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
-	"strconv"
+	"github.com/iamlucasvieira/aoc/utils/render"
+	"log/slog"
 )
 
 type (
@@ -58,13 +59,6 @@ func (n *Node) IsEmpty() bool {
 
 // NeighboursTemplate is a function that returns the neighbors of a node in a graph.
 func NeighboursTemplate(n *Node, g Graph, maxMove, turnAfter int) []*Node {
-	directions := []Point{
-		{0, -1}, // up
-		{0, 1},  // down
-		{-1, 0}, // left
-		{1, 0},  // right
-	}
-
 	var currentDirection = n.Direction
 	var previous = n
 	if n.Prev != nil {
@@ -74,10 +68,10 @@ func NeighboursTemplate(n *Node, g Graph, maxMove, turnAfter int) []*Node {
 
 	// Moving in same direction is only allowed three times in a row
 	var possibleNeighbors []*Node
-	for _, d := range directions {
+	for _, d := range utils.Directions4 {
 		var moveCount = 1
 
-		if d == currentDirection {
+		if d.Point() == currentDirection {
 			if n.MoveCount == maxMove {
 				continue
 			} else {
@@ -87,14 +81,14 @@ func NeighboursTemplate(n *Node, g Graph, maxMove, turnAfter int) []*Node {
 			continue
 		}
 
-		neighbor := n.Add(d)
+		neighbor := n.Move(d)
 
 		if g.Nodes.Contains(neighbor) && !neighbor.Equal(previous.Point) {
 			possibleNeighbors = append(possibleNeighbors, &Node{
 				Point:     neighbor,
 				Value:     n.Value + g.Get(neighbor.X, neighbor.Y).Value,
 				Prev:      n,
-				Direction: d,
+				Direction: d.Point(),
 				MoveCount: moveCount,
 			})
 		}
@@ -115,55 +109,98 @@ func NeighboursUltra(n *Node, g Graph) []*Node {
 }
 
 // parse is a function that parses the input into a Graph.
-func parse(input []string) Graph {
-	grid := make(utils.Grid[*Node], 0)
-	for y, line := range input {
-		if len(line) == 0 {
-			continue
+func parse(input []string) (Graph, error) {
+	grid, err := utils.ParseGrid(input, func(r rune, p Point) (*Node, error) {
+		val, err := utils.Digits(r, p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid heat loss: %w", err)
 		}
-		row := make([]*Node, len(line))
-		for x, char := range line {
-			// String to int
-			val, err := strconv.Atoi(string(char))
-			if err != nil {
-				panic(err)
-			}
-			row[x] = &Node{Point: Point{X: x, Y: y}, Value: val, Direction: Point{}}
-		}
-		grid = append(grid, row)
+		return &Node{Point: p, Value: val, Direction: Point{}}, nil
+	})
+	if err != nil {
+		return Graph{}, err
 	}
-	return Graph{Nodes: grid}
+	return Graph{Nodes: grid}, nil
 }
 
-// shortestDistance is a function that returns the shortest distance between two points in a graph.
-func shortestDistance(g Graph, neighbourFunc func(*Node, Graph) []*Node) int {
+// shortestPath is a function that returns the path with the least heat loss between the corners of a graph.
+func shortestPath(g Graph, neighbourFunc func(*Node, Graph) []*Node, logger *slog.Logger) []*Node {
 	h, w := g.Nodes.Height(), g.Nodes.Width()
 
 	start := g.Get(0, 0)
 	end := g.Get(w-1, h-1)
+	logger.Debug("searching shortest path", "width", w, "height", h, "start", start, "end", end)
 
 	path := utils.DijkstraTemplate[*Node](g, start, end, neighbourFunc)
+	if len(path) == 0 {
+		logger.Warn("no path found", "start", start, "end", end)
+		return nil
+	}
+
+	for _, n := range path {
+		logger.Debug("path step", "node", n, "loss", n.Value)
+	}
+	logger.Info("shortest path found", "steps", len(path), "loss", path[len(path)-1].Value)
+	return path
+}
+
+// shortestDistance is a function that returns the shortest distance between two points in a graph.
+func shortestDistance(g Graph, neighbourFunc func(*Node, Graph) []*Node, logger *slog.Logger) int {
+	return pathLoss(shortestPath(g, neighbourFunc, logger))
+}
+
+// pathLoss is a function that returns the heat loss at the end of a path, or -1 if there is no path.
+func pathLoss(path []*Node) int {
 	if len(path) == 0 {
 		return -1
 	}
 	return path[len(path)-1].Value
 }
 
-func part1() {
+// drawPath draws the graph with the path highlighted when the runner was given --live.
+func drawPath(g Graph, path []*Node) {
+	terminal, ok := render.NewLiveTerminal(func(n *Node) rune { return rune('0' + n.Value) })
+	if !ok {
+		return
+	}
+
+	points := make([]Point, len(path))
+	for i, n := range path {
+		points[i] = n.Point
+	}
+	if err := terminal.Draw(g.Nodes, points...); err != nil {
+		panic(err)
+	}
+}
+
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
-	g := parse(utils.ReadFile("input.txt"))
-	loss := shortestDistance(g, Neighbours)
+	g, err := parse(utils.ReadFile("input.txt"))
+	if err != nil {
+		panic(err)
+	}
+	path := shortestPath(g, Neighbours, utils.Logger())
+	drawPath(g, path)
+	loss := pathLoss(path)
 	fmt.Printf("The shortest path is %d\n", loss)
+	return utils.NewIntAnswer(loss)
 }
 
-func part2() {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
-	g := parse(utils.ReadFile("input2.txt"))
-	loss := shortestDistance(g, NeighboursUltra)
+	g, err := parse(utils.ReadFile("input2.txt"))
+	if err != nil {
+		panic(err)
+	}
+	path := shortestPath(g, NeighboursUltra, utils.Logger())
+	drawPath(g, path)
+	loss := pathLoss(path)
 	fmt.Printf("The shortest path is %d\n", loss)
+	return utils.NewIntAnswer(loss)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -1,6 +1,10 @@
 package main
 
 import (
+	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/logtest"
+	"io"
+	"strings"
 	"testing"
 )
 
@@ -21,7 +25,10 @@ var mockData = []string{
 }
 
 func TestParse(t *testing.T) {
-	graph := parse(mockData)
+	graph, err := parse(mockData)
+	if err != nil {
+		t.Fatalf("parse(mockData) returned error: %v", err)
+	}
 
 	if graph.Nodes.Width() != 13 {
 		t.Fatalf("graph.Nodes.Width() should be 13, but is %d", graph.Nodes.Width())
@@ -74,17 +81,23 @@ func TestNeighbours(t *testing.T) {
 }
 
 func TestShortestPath(t *testing.T) {
-	graph := parse(mockData)
+	graph, err := parse(mockData)
+	if err != nil {
+		t.Fatalf("parse(mockData) returned error: %v", err)
+	}
 
-	if distance := shortestDistance(graph, Neighbours); distance != 102 {
+	if distance := shortestDistance(graph, Neighbours, logtest.New(t)); distance != 102 {
 		t.Fatalf("shortestDistance should be 102, but is %d", distance)
 	}
 }
 
 func TestShortestPathUltra(t *testing.T) {
-	graph := parse(mockData)
+	graph, err := parse(mockData)
+	if err != nil {
+		t.Fatalf("parse(mockData) returned error: %v", err)
+	}
 
-	if distance := shortestDistance(graph, NeighboursUltra); distance != 94 {
+	if distance := shortestDistance(graph, NeighboursUltra, logtest.New(t)); distance != 94 {
 		t.Fatalf("shortestDistance should be 94, but is %d", distance)
 	}
 }
@@ -159,3 +172,36 @@ func TestNode_String(t *testing.T) {
 		t.Fatalf("n.String() should be (1, 2), but is %s", n.String())
 	}
 }
+
+func FuzzParse(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parse(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	g, err := parse(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	logger := utils.NewLogger(io.Discard, 0)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pathLoss(shortestPath(g, Neighbours, logger))
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	g, err := parse(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	logger := utils.NewLogger(io.Discard, 0)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pathLoss(shortestPath(g, NeighboursUltra, logger))
+	}
+}
@@ -3,21 +3,17 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/geom"
+	parseutil "github.com/iamlucasvieira/aoc/utils/parse"
+	"github.com/iamlucasvieira/aoc/utils/render"
+	"image/color"
 	"strconv"
-	"strings"
 )
 
 type (
 	point = utils.Point
 )
 
-var directions = map[string]point{
-	"U": {0, -1},
-	"D": {0, 1},
-	"L": {-1, 0},
-	"R": {1, 0},
-}
-
 type colorPoint struct {
 	point
 	color string
@@ -29,35 +25,22 @@ type command struct {
 	color     string
 }
 
-func parse(lines []string) ([]command, error) {
-	var commands []command
-	for _, line := range lines {
-		if line == "" {
-			continue
-		}
-
-		// split line
-		parts := strings.Split(line, " ")
-
-		if len(parts) != 3 {
-			return nil, fmt.Errorf("invalid line: %v", line)
-		}
-		direction, ok := directions[parts[0]]
-
-		if !ok {
-			return nil, fmt.Errorf("invalid direction: %v", parts[0])
-		}
-
-		steps, err := strconv.Atoi(parts[1])
-		if err != nil {
-			return nil, fmt.Errorf("invalid steps: %v", parts[1])
-		}
+// commandLine is the shape of a command in the input, e.g. "R 6 (#70c710)"
+type commandLine struct {
+	Direction utils.Direction `parse:"([UDLR]) "`
+	Steps     int             `parse:"(\\d+) "`
+	Color     string          `parse:"\\((.*)\\)"`
+}
 
-		color := parts[2]
-		// Remove the parenthesis
-		color = color[1 : len(color)-1]
+func parse(lines []string) ([]command, error) {
+	var commandLines []commandLine
+	if err := parseutil.Unmarshal(lines, &commandLines); err != nil {
+		return nil, err
+	}
 
-		commands = append(commands, command{direction, steps, color})
+	commands := make([]command, len(commandLines))
+	for i, c := range commandLines {
+		commands[i] = command{c.Direction.Point(), c.Steps, c.Color}
 	}
 	return commands, nil
 }
@@ -76,37 +59,55 @@ func buildPath(commands []command) []colorPoint {
 	return path
 }
 
-// polygonArea returns the area of a polygon
-func polygonArea(points []colorPoint) int {
-	var area int
-	nPoints := len(points)
-	for i := 0; i <= nPoints-1; i++ {
-		area += points[i].X*points[(i+1)%nPoints].Y - points[(i+1)%nPoints].X*points[i].Y
+// trench returns the dug trench, with the colour of each point.
+// The trench goes to negative coordinates, so it is kept in a sparse grid.
+func trench(path []colorPoint) *utils.SparseGrid[string] {
+	g := utils.NewSparseGrid("")
+	for _, p := range path {
+		g.Set(p.point, p.color)
 	}
+	return g
+}
 
-	if area < 0 {
-		area = -area
+// trenchColor returns the colour a point of the trench is rendered with.
+func trenchColor(s string) color.Color {
+	var c = color.RGBA{A: 255}
+	if _, err := fmt.Sscanf(s, "#%02x%02x%02x", &c.R, &c.G, &c.B); err != nil {
+		return color.RGBA{R: 20, G: 20, B: 30, A: 255}
 	}
-	return area / 2
+	return c
 }
 
-// pickleTheorem returns the number of points inside a polygon and the border
-func pickleTheorem(points []colorPoint) int {
-	var area = polygonArea(points)
-	return area + len(points)/2 + 1
+// outline returns the polygon dug by the commands, with a vertex at each turn.
+func outline(commands []command) geom.Polygon {
+	steps := make([]point, len(commands))
+	for i, c := range commands {
+		steps[i] = c.direction.Mul(point{X: c.steps, Y: c.steps})
+	}
+	return geom.Outline(point{}, steps)
 }
 
-func part1() int {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	commands, err := parse(utils.ReadFile("input.txt"))
 	if err != nil {
 		panic(err)
 	}
 
-	path := buildPath(commands)
-	count := pickleTheorem(path)
+	count := outline(commands).LatticePoints()
 	fmt.Printf("The number of points is %d\n", count)
-	return count
+
+	if out, ok := render.OutputPath("part1"); ok {
+		// The trench goes to negative coordinates, so the image starts at the top left corner of its bounds
+		g, corner := trench(buildPath(commands)).Grid()
+		anim := render.NewAnimation(trenchColor, 2, 0)
+		anim.Add(g)
+		if err := anim.Save(out); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Rendered the trench from %v to %s\n", corner, out)
+	}
+	return utils.NewIntAnswer(count)
 }
 
 // commandsFromHex converts the commands from hex to decimal
@@ -129,25 +130,27 @@ func commandsFromHex(commands []command) ([]command, error) {
 			return nil, fmt.Errorf("invalid distance: %v", distanceStr)
 		}
 
-		var direction point
+		var direction utils.Direction
 
 		switch directionStr {
 		case "0":
-			direction = directions["R"]
+			direction = utils.Right
 		case "1":
-			direction = directions["D"]
+			direction = utils.Down
 		case "2":
-			direction = directions["L"]
+			direction = utils.Left
 		case "3":
-			direction = directions["U"]
+			direction = utils.Up
+		default:
+			return nil, fmt.Errorf("invalid direction: %v", directionStr)
 		}
 
-		newCommands = append(newCommands, command{direction, int(distance), commands[c].color})
+		newCommands = append(newCommands, command{direction.Point(), int(distance), commands[c].color})
 	}
 	return newCommands, nil
 }
 
-func part2() int {
+func part2() utils.Answer {
 	fmt.Println("Part 2:")
 	commands, err := parse(utils.ReadFile("input2.txt"))
 	if err != nil {
@@ -159,13 +162,14 @@ func part2() int {
 		panic(err)
 	}
 
-	path := buildPath(commands)
-	count := pickleTheorem(path)
+	// The lagoon is too large to dig every point, and its area is counted exactly with big integers
+	count := outline(commands).Big().LatticePoints()
 	fmt.Printf("The number of points is %d\n", count)
-	return count
+	return utils.NewBigAnswer(count)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
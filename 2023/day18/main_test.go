@@ -2,6 +2,9 @@ package main
 
 import (
 	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
+	"image/color"
+	"strings"
 	"testing"
 )
 
@@ -66,21 +69,70 @@ func TestBuildPath(t *testing.T) {
 	}
 }
 
+func TestTrench(t *testing.T) {
+	testCases := []struct {
+		name   string
+		data   []string
+		bounds utils.Rect
+		points int
+	}{
+		{"mock data", mockData, utils.NewRect(0, 0, 7, 10), 38},
+		{"negative coordinates", mockData2, utils.NewRect(-2, -2, 3, 3), 8},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			commands, err := parse(tc.data)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			g := trench(buildPath(commands))
+			if got := g.Bounds(); got != tc.bounds {
+				t.Errorf("got bounds %v, want %v", got, tc.bounds)
+			}
+			if got := g.Len(); got != tc.points {
+				t.Errorf("got %d points, want %d", got, tc.points)
+			}
+			if got := g.Get(utils.Point{X: -1, Y: -1}); got != "" {
+				t.Errorf("got %q inside the trench, want empty", got)
+			}
+		})
+	}
+}
+
+func TestTrenchColor(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  color.Color
+	}{
+		{"#70c710", color.RGBA{R: 0x70, G: 0xc7, B: 0x10, A: 255}},
+		{"", color.RGBA{R: 20, G: 20, B: 30, A: 255}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			if got := trenchColor(tc.input); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestPolygonArea(t *testing.T) {
 	commands, err := parse(mockData2)
-	path := buildPath(commands)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	area := polygonArea(path[1:])
+	area := outline(commands).Area()
 	if area != 4 {
-		t.Fatalf("expected 4, got %d", area)
+		t.Fatalf("expected 4, got %v", area)
 	}
 
 }
 
-func TestPickleTheorem(t *testing.T) {
+func TestLatticePoints(t *testing.T) {
 
 	testCases := []struct {
 		commands []string
@@ -97,8 +149,7 @@ func TestPickleTheorem(t *testing.T) {
 				t.Fatalf("unexpected error: %v", err)
 			}
 
-			path := buildPath(commands)
-			got := pickleTheorem(path)
+			got := outline(commands).LatticePoints()
 			if got != tc.want {
 				t.Fatalf("expected %d, got %d", tc.want, got)
 			}
@@ -159,7 +210,7 @@ func TestCommandsFromHex(t *testing.T) {
 
 }
 
-func TestPickleTheoremHex(t *testing.T) {
+func TestLatticePointsHex(t *testing.T) {
 	commands, err := parse(mockData)
 	want := 952408144115
 	if err != nil {
@@ -171,8 +222,7 @@ func TestPickleTheoremHex(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	path := buildPath(commands)
-	got := pickleTheorem(path)
+	got := outline(commands).LatticePoints()
 	if got != want {
 		t.Fatalf("expected %d, got %d", want, got)
 	}
@@ -180,8 +230,56 @@ func TestPickleTheoremHex(t *testing.T) {
 
 func TestPart1(t *testing.T) {
 	value := part1()
-	want := 34329
-	if value != want {
-		t.Fatalf("expected %d, got %d", want, value)
+	want := utils.NewIntAnswer(34329)
+	if !value.Equal(want) {
+		t.Fatalf("expected %v, got %v", want, value)
+	}
+}
+
+func TestPart2(t *testing.T) {
+	value := part2()
+	want := utils.NewIntAnswer(int64(42617947302920))
+	if !value.Equal(want) {
+		t.Fatalf("expected %v, got %v", want, value)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Add(strings.Join(mockData2, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		commands, err := parse(strings.Split(input, "\n"))
+		if err != nil {
+			return
+		}
+		_, _ = commandsFromHex(commands)
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	commands, err := parse(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		outline(commands).LatticePoints()
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	commands, err := parse(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	commands, err = commandsFromHex(commands)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		outline(commands).Big().LatticePoints()
 	}
 }
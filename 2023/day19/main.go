@@ -3,86 +3,22 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/dot"
+	"github.com/iamlucasvieira/aoc/utils/interval"
+	parseutil "github.com/iamlucasvieira/aoc/utils/parse"
+	"sort"
 	"strconv"
 	"strings"
 )
 
 type partsMap map[string]int
-type partsMapRange map[string]ranges
 
-// update updates the parts map based on the action
-func (p partsMapRange) update(key string, value ranges) partsMapRange {
-	var mapCopy = make(partsMapRange)
+// partAxes are the axes of a box of parts for each rating.
+var partAxes = map[string]int{"x": 0, "m": 1, "a": 2, "s": 3}
 
-	for k, v := range p {
-		mapCopy[k] = v
-	}
-	// make a copy of the map
-	mapCopy[key] = value
-	return mapCopy
-}
-
-type ranges struct {
-	min int
-	max int
-}
-
-// update updates the ranges based on the action
-func (r ranges) update(a action) ranges {
-	if a.operation == ">" && a.value > r.min {
-		r.min = a.value + 1
-	} else if a.operation == "<" && a.value < r.max {
-		r.max = a.value - 1
-	}
-	return r
-}
-
-// Returns two ranges that are the result of splitting the ranges based on the action
-// The first satisfies the action and the second does not
-func (r ranges) split(a action) (ranges, ranges) {
-	if a.operation == ">" {
-		if a.value > r.max {
-			return ranges{}, r
-		} else if a.value < r.min {
-			return r, ranges{}
-		} else {
-			return ranges{min: a.value + 1, max: r.max}, ranges{min: r.min, max: a.value}
-		}
-	} else if a.operation == "<" {
-		if a.value > r.max {
-			return r, ranges{}
-		} else if a.value < r.min {
-			return ranges{}, r
-		} else {
-			return ranges{min: r.min, max: a.value - 1}, ranges{min: a.value, max: r.max}
-		}
-	}
-	return ranges{}, ranges{}
-}
-
-// isValid checks if the ranges are valid
-func (r ranges) isValid() bool {
-	return r.min <= r.max
-}
-
-// len returns the length of the ranges
-func (r ranges) len() int {
-	return r.max - r.min + 1
-}
-
-// isEmpty checks if the ranges are empty
-func (r ranges) isEmpty() bool {
-	return r.min == 0 && r.max == 0
-}
-
-// newPartsMapRanges creates ranges for each part in the parts map
-func newPartsMapRanges(defaultMin, defaultMax int) partsMapRange {
-	return partsMapRange{
-		"x": {min: defaultMin, max: defaultMax},
-		"m": {min: defaultMin, max: defaultMax},
-		"a": {min: defaultMin, max: defaultMax},
-		"s": {min: defaultMin, max: defaultMax},
-	}
+// newPartsBox creates the box of parts with every rating from minRating to maxRating, both included.
+func newPartsBox(minRating, maxRating int) interval.Box {
+	return interval.NewBox(len(partAxes), interval.Closed(minRating, maxRating))
 }
 
 // newParts creates a map of parts amounts from a string of part
@@ -91,25 +27,9 @@ func newParts(s string) (partsMap, error) {
 	// Remove the curly braces
 	s = strings.Trim(s, "{}")
 
-	// Split the string into parts
-	split := strings.Split(s, ",")
-
-	parts := make(map[string]int)
-
-	for _, part := range split {
-		partSplit := strings.Split(part, "=")
-		if len(partSplit) != 2 {
-			return nil, fmt.Errorf("NewParts: Wrong format in %s", s)
-		}
-
-		partName := partSplit[0]
-		partAmount, err := strconv.Atoi(partSplit[1])
-
-		if err != nil {
-			return nil, fmt.Errorf("NewParts: Wrong format in %s", s)
-		}
-
-		parts[partName] = partAmount
+	parts, err := parseutil.IntFields(s, ",", "=")
+	if err != nil {
+		return nil, fmt.Errorf("NewParts: Wrong format in %s: %w", s, err)
 	}
 
 	// Check if map contains x, m, a, s
@@ -137,6 +57,23 @@ func (a action) isEnd() bool {
 	return a.operation == "" && a.value == 0 && a.part == ""
 }
 
+// split returns the parts of a box that satisfy the action, and the parts that do not.
+func (a action) split(parts interval.Box) (interval.Box, interval.Box) {
+	axis, ok := partAxes[a.part]
+	if !ok {
+		return interval.Box{}, interval.Box{}
+	}
+
+	switch a.operation {
+	case ">":
+		unsatisfied, satisfied := parts.SplitAt(axis, a.value+1)
+		return satisfied, unsatisfied
+	case "<":
+		return parts.SplitAt(axis, a.value)
+	}
+	return interval.Box{}, interval.Box{}
+}
+
 func (a action) evaluate(p partsMap) bool {
 
 	if a.isEnd() {
@@ -154,42 +91,32 @@ func (a action) evaluate(p partsMap) bool {
 	return false
 }
 
+// conditionLine is the shape of the condition of an action in the input, e.g. "a<2006"
+type conditionLine struct {
+	Part      string `parse:"(\\w+)"`
+	Operation string `parse:"([<>])"`
+	Value     int    `parse:"(\\d+)"`
+}
+
+// actionLine is the shape of an action in the input, e.g. "a<2006:qkq" or "rfg", where the condition is optional
+type actionLine struct {
+	Condition   conditionLine `parse:"(?:(\\w+[<>]\\d+):)?"`
+	Destination string        `parse:"(\\w+)"`
+}
+
 // newAction creates a new action from a string
 // The string must be in the format of: "part>value:destination" or "destination" or "part<value:destination"
 func newAction(s string) (action, error) {
-	split := strings.Split(s, ":")
-	if len(split) == 1 {
-		return action{destination: s}, nil
-	}
-
-	destination := split[1]
-	var operation string
-	if strings.Contains(split[0], "<") {
-		operation = "<"
-	} else if strings.Contains(split[0], ">") {
-		operation = ">"
-	} else {
-		return action{}, fmt.Errorf("NewAction: Wrong operation type in %s, must be '>' or '<'", s)
-	}
-
-	split = strings.Split(split[0], operation)
-
-	if len(split) != 2 {
-		return action{}, fmt.Errorf("NewAction: Operation must split into two parts in %s", s)
-	}
-
-	part := split[0]
-	value, err := strconv.Atoi(split[1])
-
-	if err != nil {
-		return action{}, fmt.Errorf("NewAction: The value after the operation in %s must be an int", s)
+	var line actionLine
+	if err := parseutil.UnmarshalLine(s, &line); err != nil {
+		return action{}, fmt.Errorf("NewAction: %w", err)
 	}
 
 	return action{
-		part:        part,
-		operation:   operation,
-		value:       value,
-		destination: destination,
+		part:        line.Condition.Part,
+		operation:   line.Condition.Operation,
+		value:       line.Condition.Value,
+		destination: line.Destination,
 	}, nil
 }
 
@@ -236,6 +163,32 @@ func parse(lines []string) (rules, []partsMap, error) {
 	return r, parts, nil
 }
 
+// toDot returns the workflows as a DOT graph, with an edge labelled by its condition for each action
+func (r rules) toDot() *dot.Graph {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	g := dot.NewDigraph("workflows")
+	g.Attrs["rankdir"] = "LR"
+	g.Node("A").Shape("doublecircle").Color("green")
+	g.Node("R").Shape("doublecircle").Color("red")
+
+	for _, name := range names {
+		g.Node(name).Shape("box")
+		for _, a := range r[name] {
+			e := g.Edge(name, a.destination)
+			if !a.isEnd() {
+				e.Label(a.part + a.operation + strconv.Itoa(a.value))
+			}
+		}
+	}
+	g.Node("in").Shape("Mdiamond")
+	return g
+}
+
 func numPartsAccepted(r rules, parts []partsMap) int {
 	var numAccepted int
 
@@ -263,16 +216,14 @@ func numPartsAccepted(r rules, parts []partsMap) int {
 	return numAccepted
 }
 
-func sumPossibleAcceptableParts(ruleName string, idx int, r rules, parts partsMapRange) int {
-	// If any range in the map is invalid or empty, return 0
-	for _, r := range parts {
-		if !r.isValid() || r.isEmpty() {
-			return 0
-		}
+func sumPossibleAcceptableParts(ruleName string, idx int, r rules, parts interval.Box) int {
+	// If there are no parts left, none are acceptable
+	if parts.Empty() {
+		return 0
 	}
 
 	if ruleName == "A" {
-		return parts["x"].len() * parts["m"].len() * parts["a"].len() * parts["s"].len()
+		return parts.Volume()
 	} else if ruleName == "R" {
 		return 0 // No parts are acceptable
 	}
@@ -288,18 +239,15 @@ func sumPossibleAcceptableParts(ruleName string, idx int, r rules, parts partsMa
 		return sumPossibleAcceptableParts(nextDestination, 0, r, parts)
 	}
 
-	// The part that is being tested
-	partTested := currentAction.part
-
-	// Get the ranges that satisfy the current action
-	satisfied, unsatisfied := parts[partTested].split(currentAction)
+	// Get the parts that satisfy the current action
+	satisfied, unsatisfied := currentAction.split(parts)
 
 	// For the satisfied we move to the next destination
 	satisfiedSum := sumPossibleAcceptableParts(
 		nextDestination,
 		0,
 		r,
-		parts.update(partTested, satisfied),
+		satisfied,
 	)
 
 	// For the unsatisfied we move to the next action
@@ -307,35 +255,44 @@ func sumPossibleAcceptableParts(ruleName string, idx int, r rules, parts partsMa
 		ruleName,
 		idx+1,
 		r,
-		parts.update(partTested, unsatisfied),
+		unsatisfied,
 	)
 
 	return satisfiedSum + unsatisfiedSum
 }
 
-func part1() int {
+func part1() utils.Answer {
 	r, p, err := parse(utils.ReadFile("input.txt"))
 	if err != nil {
 		panic(err)
 	}
+
+	if path, ok := dot.OutputPath(); ok {
+		if err := r.toDot().Save(path); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Saved the workflows graph to %s\n", path)
+	}
 	sum := numPartsAccepted(r, p)
 	fmt.Printf("Part 1: %d\n", sum)
-	return sum
+	return utils.NewIntAnswer(sum)
 }
 
-func part2() int {
+func part2() utils.Answer {
 	r, _, err := parse(utils.ReadFile("input2.txt"))
 	if err != nil {
 		panic(err)
 	}
 
-	parts := newPartsMapRanges(1, 4000)
+	parts := newPartsBox(1, 4000)
 
 	sum := sumPossibleAcceptableParts("in", 0, r, parts)
 	fmt.Printf("Part 2: %d\n", sum)
-	return sum
+	return utils.NewIntAnswer(sum)
 }
+
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -1,7 +1,10 @@
 package main
 
 import (
+	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/interval"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -148,6 +151,32 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestRules_ToDot(t *testing.T) {
+	r, _, err := parse([]string{"in{s<1351:px,A}", "px{a>10:R,in}"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := strings.Join([]string{
+		`digraph "workflows" {`,
+		`	graph [rankdir="LR"];`,
+		`	"A" [color="green", shape="doublecircle"];`,
+		`	"R" [color="red", shape="doublecircle"];`,
+		`	"in" [shape="Mdiamond"];`,
+		`	"px" [shape="box"];`,
+		`	"in" -> "px" [label="s<1351"];`,
+		`	"in" -> "A";`,
+		`	"px" -> "R" [label="a>10"];`,
+		`	"px" -> "in";`,
+		`}`,
+		``,
+	}, "\n")
+
+	if got := r.toDot().String(); got != want {
+		t.Errorf("toDot() got\n%s\nwant\n%s", got, want)
+	}
+}
+
 func TestAction_Evaluate(t *testing.T) {
 	p := partsMap(map[string]int{"x": 787, "m": 2655, "a": 1222, "s": 2876})
 
@@ -221,238 +250,98 @@ func TestNumPartsAccepted(t *testing.T) {
 }
 
 func TestPart1(t *testing.T) {
-	want := 495298
+	want := utils.NewIntAnswer(495298)
 	actual := part1()
 
-	if actual != want {
-		t.Errorf("Part1: Expected %d, got %d", want, actual)
+	if !actual.Equal(want) {
+		t.Errorf("Part1: Expected %v, got %v", want, actual)
 	}
 }
 
-func TestRanges_update(t *testing.T) {
-	r := ranges{min: 0, max: 10}
-
-	testCases := []struct {
-		input    string
-		expected ranges
-	}{
-		{
-			input:    "x>2:5",
-			expected: ranges{min: 3, max: 10},
-		},
-		{
-			input:    "x>800:5",
-			expected: ranges{min: 801, max: 10},
-		},
-		{
-			input:    "x<800:5",
-			expected: ranges{min: 0, max: 10},
-		},
-		{
-			input:    "x<2:5",
-			expected: ranges{min: 0, max: 1},
-		},
-	}
+func TestNewPartsBox(t *testing.T) {
+	parts := newPartsBox(1, 10)
 
-	for _, testCase := range testCases {
-		t.Run(testCase.input, func(t *testing.T) {
-			a, err := newAction(testCase.input)
-			if err != nil {
-				t.Errorf("NewAction: %s", err)
-			}
-			got := r.update(a)
-			if got != testCase.expected {
-				t.Errorf("Ranges.update: Expected %v, got %v", testCase.expected, got)
-			}
-		})
+	if len(parts) != 4 {
+		t.Fatalf("NewPartsBox: Expected 4 ratings, got %d", len(parts))
 	}
-}
-
-func TestRanges_Valid(t *testing.T) {
-	testCases := []struct {
-		id       string
-		input    ranges
-		expected bool
-	}{
-		{
-			id:       "min < max",
-			input:    ranges{min: 0, max: 10},
-			expected: true,
-		},
-		{
-			id:       "min == max",
-			input:    ranges{min: 10, max: 0},
-			expected: false,
-		},
+	for _, part := range []string{"x", "m", "a", "s"} {
+		if got := parts[partAxes[part]]; got != interval.Closed(1, 10) {
+			t.Errorf("NewPartsBox: Expected %s to be %v, got %v", part, interval.Closed(1, 10), got)
+		}
 	}
-
-	for _, testCase := range testCases {
-		t.Run(testCase.id, func(t *testing.T) {
-			got := testCase.input.isValid()
-			if got != testCase.expected {
-				t.Errorf("Ranges.isValid: Expected %v, got %v", testCase.expected, got)
-			}
-		})
+	if got := parts.Volume(); got != 10000 {
+		t.Errorf("NewPartsBox: Expected 10000 parts, got %d", got)
 	}
 }
 
-func TestRanges_Len(t *testing.T) {
-	testCases := []struct {
-		id       string
-		input    ranges
-		expected int
-	}{
-		{
-			id:       "min < max",
-			input:    ranges{min: 0, max: 10},
-			expected: 11,
-		},
-		{
-			id:       "min == max",
-			input:    ranges{min: 10, max: 10},
-			expected: 1,
-		},
-	}
-
-	for _, testCase := range testCases {
-		t.Run(testCase.id, func(t *testing.T) {
-			got := testCase.input.len()
-			if got != testCase.expected {
-				t.Errorf("Ranges.len: Expected %d, got %d", testCase.expected, got)
-			}
-		})
-	}
-}
-
-func TestNewPartsMapRanges(t *testing.T) {
-	want := partsMapRange{
-		"x": {min: 0, max: 10},
-		"m": {min: 0, max: 10},
-		"a": {min: 0, max: 10},
-		"s": {min: 0, max: 10},
-	}
-	actual := newPartsMapRanges(0, 10)
-
-	if !reflect.DeepEqual(actual, want) {
-		t.Errorf("NewPartsMapRanges: Expected %v, got %v", want, actual)
-	}
-}
-
-func TestRanges_Split(t *testing.T) {
-	r := ranges{min: 5, max: 10}
+func TestAction_Split(t *testing.T) {
+	parts := newPartsBox(5, 10)
 	testCases := []struct {
 		action             string
-		wantSatisfies      ranges
-		wantDoesNotSatisfy ranges
+		wantSatisfies      interval.Interval
+		wantDoesNotSatisfy interval.Interval
 	}{
 		{
 			action:             "x>1:5",
-			wantSatisfies:      ranges{min: 5, max: 10},
-			wantDoesNotSatisfy: ranges{},
+			wantSatisfies:      interval.Closed(5, 10),
+			wantDoesNotSatisfy: interval.Interval{},
 		},
 		{
 			action:             "x>7:5",
-			wantSatisfies:      ranges{min: 8, max: 10},
-			wantDoesNotSatisfy: ranges{min: 5, max: 7},
+			wantSatisfies:      interval.Closed(8, 10),
+			wantDoesNotSatisfy: interval.Closed(5, 7),
 		},
 		{
 			action:             "x>15:5",
-			wantSatisfies:      ranges{},
-			wantDoesNotSatisfy: ranges{min: 5, max: 10},
+			wantSatisfies:      interval.Interval{},
+			wantDoesNotSatisfy: interval.Closed(5, 10),
 		},
 
 		{
 			action:             "x<1:5",
-			wantSatisfies:      ranges{},
-			wantDoesNotSatisfy: ranges{min: 5, max: 10},
+			wantSatisfies:      interval.Interval{},
+			wantDoesNotSatisfy: interval.Closed(5, 10),
 		},
 		{
 			action:             "x<7:5",
-			wantSatisfies:      ranges{min: 5, max: 6},
-			wantDoesNotSatisfy: ranges{min: 7, max: 10},
+			wantSatisfies:      interval.Closed(5, 6),
+			wantDoesNotSatisfy: interval.Closed(7, 10),
 		},
 		{
 			action:             "x<15:5",
-			wantSatisfies:      ranges{min: 5, max: 10},
-			wantDoesNotSatisfy: ranges{},
+			wantSatisfies:      interval.Closed(5, 10),
+			wantDoesNotSatisfy: interval.Interval{},
 		},
 	}
 
+	// sameRatings checks if two intervals of ratings have the same parts, as empty intervals can have any bounds
+	sameRatings := func(a, b interval.Interval) bool {
+		return a == b || (a.Empty() && b.Empty())
+	}
+
 	for _, testCase := range testCases {
 		t.Run(testCase.action, func(t *testing.T) {
 			a, err := newAction(testCase.action)
 			if err != nil {
 				t.Errorf("NewAction: %s", err)
 			}
-			gotSatisfies, gotDoesNotSatisfy := r.split(a)
-			if !reflect.DeepEqual(gotSatisfies, testCase.wantSatisfies) {
-				t.Errorf("Ranges.split: Expected %v, got %v", testCase.wantSatisfies, gotSatisfies)
+			gotSatisfies, gotDoesNotSatisfy := a.split(parts)
+			if !sameRatings(gotSatisfies[0], testCase.wantSatisfies) {
+				t.Errorf("Action.split: Expected %v, got %v", testCase.wantSatisfies, gotSatisfies[0])
 			}
-			if !reflect.DeepEqual(gotDoesNotSatisfy, testCase.wantDoesNotSatisfy) {
-				t.Errorf("Ranges.split: Expected %v, got %v", testCase.wantDoesNotSatisfy, gotDoesNotSatisfy)
+			if !sameRatings(gotDoesNotSatisfy[0], testCase.wantDoesNotSatisfy) {
+				t.Errorf("Action.split: Expected %v, got %v", testCase.wantDoesNotSatisfy, gotDoesNotSatisfy[0])
 			}
-		})
-	}
-}
-
-func TestRanges_IsEmpty(t *testing.T) {
-	testCases := []struct {
-		description string
-		input       ranges
-		want        bool
-	}{
-		{
-			description: "ranges{}",
-			input:       ranges{},
-			want:        true,
-		},
-		{
-			description: "ranges{min: 0, max: 1}",
-			input:       ranges{min: 0, max: 1},
-			want:        false,
-		},
-	}
 
-	for _, tc := range testCases {
-		t.Run(tc.description, func(t *testing.T) {
-			got := tc.input.isEmpty()
-			if got != tc.want {
-				t.Errorf("Ranges.isEmpty: Expected %v, got %v", tc.want, got)
+			if parts[0] != interval.Closed(5, 10) {
+				t.Errorf("Action.split: Expected the parts to be untouched, got %v", parts)
 			}
-		})
-	}
-}
-
-func TestPartsMapRanges_Update(t *testing.T) {
-	p := newPartsMapRanges(0, 10)
 
-	testCases := []struct {
-		key   string
-		value ranges
-	}{
-		{
-			key:   "x",
-			value: ranges{min: 5, max: 10},
-		},
-		{
-			key:   "m",
-			value: ranges{min: 5, max: 10},
-		},
-		{
-			key:   "a",
-			value: ranges{min: 5, max: 10},
-		},
-		{
-			key:   "s",
-			value: ranges{min: 5, max: 10},
-		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.key, func(t *testing.T) {
-			got := p.update(tc.key, tc.value)
-			if !reflect.DeepEqual(got[tc.key], tc.value) {
-				t.Errorf("PartsMapRanges.update: Expected %v, got %v", tc.value, got[tc.key])
+			// Other ratings are not split
+			for _, box := range []interval.Box{gotSatisfies, gotDoesNotSatisfy} {
+				if box[1] != parts[1] || box[2] != parts[2] || box[3] != parts[3] {
+					t.Errorf("Action.split: Expected only x to change, got %v", box)
+				}
 			}
 		})
 	}
@@ -504,7 +393,7 @@ func TestSumPossibleAcceptablePartsSimple(t *testing.T) {
 			if err != nil {
 				t.Errorf("Parse: %s", err)
 			}
-			p := newPartsMapRanges(1, 10)
+			p := newPartsBox(1, 10)
 			got := sumPossibleAcceptableParts("in", 0, r, p)
 			if got != tc.want {
 				t.Errorf("SumPossibleAcceptableParts: Expected %d, got %d", tc.want, got)
@@ -521,7 +410,7 @@ func TestSumPossibleAcceptableParts(t *testing.T) {
 
 	want := 167409079868000
 
-	p := newPartsMapRanges(1, 4000)
+	p := newPartsBox(1, 4000)
 
 	start := "in"
 	actual := sumPossibleAcceptableParts(start, 0, r, p)
@@ -533,10 +422,50 @@ func TestSumPossibleAcceptableParts(t *testing.T) {
 }
 
 func TestPart2(t *testing.T) {
-	want := 132186256794011
+	want := utils.NewIntAnswer(132186256794011)
 	actual := part2()
 
-	if actual != want {
-		t.Errorf("Part2: Expected %d, got %d", want, actual)
+	if !actual.Equal(want) {
+		t.Errorf("Part2: Expected %v, got %v", want, actual)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _, _ = parse(strings.Split(input, "\n"))
+	})
+}
+
+func FuzzNewAction(f *testing.F) {
+	f.Add("a<2006:qkq")
+	f.Add("m>2090:A")
+	f.Add("rfg")
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = newAction(input)
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	r, p, err := parse(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		numPartsAccepted(r, p)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	r, _, err := parse(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumPossibleAcceptableParts("in", 0, r, newPartsBox(1, 4000))
 	}
 }
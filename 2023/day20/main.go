@@ -3,6 +3,10 @@ package main
 import (
 	"fmt"
 	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/dot"
+	"github.com/iamlucasvieira/aoc/utils/vcd"
+	"slices"
+	"sort"
 	"strings"
 )
 
@@ -14,6 +18,7 @@ type message struct {
 type nodeInterface interface {
 	AddNext(nodeInterface)
 	AddPrev(nodeInterface)
+	Next() []nodeInterface
 	Status() string
 	PreviousHigh() bool
 	Reply(nodeInterface, bool) []message
@@ -34,6 +39,11 @@ func (n *node) AddNext(next nodeInterface) {
 	n.next = append(n.next, next)
 }
 
+// Next returns the nodes this node sends signals to
+func (n *node) Next() []nodeInterface {
+	return n.next
+}
+
 // AddPrev adds a previous node
 func (n *node) AddPrev(prev nodeInterface) {
 	n.prev = append(n.prev, prev)
@@ -208,6 +218,10 @@ func parse(lines []string) (nodesMap, error) {
 		name := parts[0]
 		movingTo := strings.Split(parts[1], ",")
 
+		if len(name) == 0 {
+			return nil, fmt.Errorf("parse - missing node name: %s", line)
+		}
+
 		// The first part is the name of the node
 		switch name[0] {
 		case 'b':
@@ -220,6 +234,8 @@ func parse(lines []string) (nodesMap, error) {
 			nodes[name] = &conjunction{node: node{name: name}, memory: make(map[string]bool)}
 		case 'e':
 			nodes[name] = &end{node: node{name: name}}
+		default:
+			return nil, fmt.Errorf("parse - unknown node type: %s", name)
 		}
 
 		// The second part is the name of the node(s) it is connected to
@@ -242,6 +258,37 @@ func parse(lines []string) (nodesMap, error) {
 	return nodes, nil
 }
 
+// toDot returns the circuit as a DOT graph, with a shape for each node type
+func toDot(nodes nodesMap) *dot.Graph {
+	names := make([]string, 0, len(nodes))
+	for name := range nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	g := dot.NewDigraph("circuit")
+	for _, name := range names {
+		n := g.Node(name)
+		switch nodes[name].(type) {
+		case *broadcast:
+			n.Shape("Mdiamond")
+		case *flip:
+			n.Shape("box").Label("%" + name)
+		case *conjunction:
+			n.Shape("invhouse").Label("&" + name)
+		case *end:
+			n.Shape("doublecircle")
+		}
+	}
+
+	for _, name := range names {
+		for _, next := range nodes[name].Next() {
+			g.Edge(name, next.Name())
+		}
+	}
+	return g
+}
+
 // nPressUntil returns the number of press until a node receives a given signal
 func nPressUntil(nodes nodesMap, to nodeInterface, high bool) int {
 	var count int
@@ -265,12 +312,105 @@ func nPressUntil(nodes nodesMap, to nodeInterface, high bool) int {
 	}
 }
 
+// event is a message sent while pressing the button
+type event struct {
+	press int // button press the message was sent in, starting at 1
+	time  int // logical time the message was sent at, increasing over all presses
+	from  string
+	to    string
+	high  bool
+}
+
+// recorder records the messages sent while pressing the button.
+// A nil recorder records nothing.
+type recorder struct {
+	events []event
+	press  int
+	time   int
+}
+
+// newPress starts recording a new button press
+func (r *recorder) newPress() {
+	if r == nil {
+		return
+	}
+	r.press++
+}
+
+// record records a message from the button or a node
+func (r *recorder) record(from, to string, high bool) {
+	if r == nil {
+		return
+	}
+	r.events = append(r.events, event{press: r.press, time: r.time, from: from, to: to, high: high})
+	r.time++
+}
+
+// filter returns the events that match a function
+func (r *recorder) filter(match func(event) bool) []event {
+	var events []event
+	for _, e := range r.events {
+		if match(e) {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// firstPress returns the first press in which a node sends a high or low signal
+func (r *recorder) firstPress(from string, high bool) (int, bool) {
+	for _, e := range r.events {
+		if e.from == from && e.high == high {
+			return e.press, true
+		}
+	}
+	return 0, false
+}
+
+// vcd returns the recorded signals as a value change dump, with a wire for the last signal sent by each node
+// and an integer with the current press
+func (r *recorder) vcd() (*vcd.Dump, error) {
+	d := vcd.NewDump("circuit")
+	if err := d.Integer("press", 32); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range r.events {
+		if !slices.Contains(names, e.from) {
+			names = append(names, e.from)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := d.Wire(name); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, e := range r.events {
+		if err := d.Change(e.time, "press", e.press); err != nil {
+			return nil, err
+		}
+		value := 0
+		if e.high {
+			value = 1
+		}
+		if err := d.Change(e.time, e.from, value); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
 // pressButton presses the button on the broadcaster node
-func pressButton(nodes nodesMap) (int, int) {
+func pressButton(nodes nodesMap, rec *recorder) (int, int) {
 	nHigh, nLow := 0, 1
 	var queue []message
 
 	// Add the first message to the queue
+	rec.newPress()
+	rec.record("button", "broadcaster", false)
 	m := nodes["broadcaster"].Reply(nil, false)
 
 	queue = append(queue, m...)
@@ -278,6 +418,7 @@ func pressButton(nodes nodesMap) (int, int) {
 	for len(queue) > 0 {
 		// Get the first message from the queue
 		m := queue[0]
+		rec.record(m.from.Name(), m.to.Name(), m.high)
 
 		if m.high {
 			nHigh++
@@ -296,10 +437,10 @@ func pressButton(nodes nodesMap) (int, int) {
 }
 
 // pressButtonN presses the button on the broadcaster node n times
-func pressButtonN(nodes nodesMap, n int) (int, int) {
+func pressButtonN(nodes nodesMap, n int, rec *recorder) (int, int) {
 	var nHigh, nLow int
 	for i := 0; i < n; i++ {
-		h, l := pressButton(nodes)
+		h, l := pressButton(nodes, rec)
 		nHigh += h
 		nLow += l
 	}
@@ -308,48 +449,88 @@ func pressButtonN(nodes nodesMap, n int) (int, int) {
 }
 
 // productHighLows returns the product of the number of high and low signals
-func productHighLows(nodes nodesMap, n int) int {
-	nHigh, nLow := pressButtonN(nodes, n)
+func productHighLows(nodes nodesMap, n int, rec *recorder) int {
+	nHigh, nLow := pressButtonN(nodes, n, rec)
 	return nHigh * nLow
 }
 
-func part1() int {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	n, err := parse(utils.ReadFile("input.txt"))
 	if err != nil {
 		panic(err)
 	}
 
-	prod := productHighLows(n, 1000)
+	if path, ok := dot.OutputPath(); ok {
+		if err := toDot(n).Save(path); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Saved the circuit graph to %s\n", path)
+	}
+
+	var rec *recorder
+	path, toRecord := vcd.OutputPath()
+	if toRecord {
+		rec = &recorder{}
+	}
+
+	prod := productHighLows(n, 1000, rec)
 	fmt.Printf("The product of the number of high and low signals is %d\n", prod)
-	return prod
+
+	if toRecord {
+		d, err := rec.vcd()
+		if err != nil {
+			panic(err)
+		}
+		if err := d.Save(path); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Saved %d signals to %s\n", len(rec.events), path)
+	}
+	return utils.NewIntAnswer(prod)
 }
 
-func part2() int {
-	fmt.Println("Part 2:")
-	n, err := parse(utils.ReadFile("input2.txt"))
+// pressesUntilRx returns the number of presses until rx receives a low signal.
+// rx is fed by a conjunction of nodes that each receive a low signal on a cycle, so it is the LCM of their first presses.
+// Pressing the button changes the state of the nodes, so each node is counted on its own circuit.
+func pressesUntilRx(lines []string) (int, error) {
+	n, err := parse(lines)
 	if err != nil {
-		panic(err)
+		return 0, err
+	}
+	final, ok := n["rx"].(*end)
+	if !ok || len(final.prev) == 0 {
+		return 0, fmt.Errorf("rx is not an end node with an input")
+	}
+	feeder, ok := final.prev[0].(*conjunction)
+	if !ok {
+		return 0, fmt.Errorf("rx is not fed by a conjunction")
 	}
-	final := n["rx"]
-	var numbers []int
-	previous := final.(*end).prev[0].(*conjunction).prev
 
-	for p := range previous {
-		n, err := parse(utils.ReadFile("input2.txt"))
+	var numbers []int
+	for _, p := range feeder.prev {
+		n, err := parse(lines)
 		if err != nil {
-			panic(err)
+			return 0, err
 		}
-		numbers = append(numbers, nPressUntil(n, previous[p], false))
+		numbers = append(numbers, nPressUntil(n, p, false))
 	}
+	return utils.LCM(numbers...), nil
+}
 
-	commonCycle := utils.LCM(numbers...)
+func part2() utils.Answer {
+	fmt.Println("Part 2:")
+	commonCycle, err := pressesUntilRx(utils.ReadFile("input2.txt"))
+	if err != nil {
+		panic(err)
+	}
 
 	fmt.Printf("First time after pressing %d times\n", commonCycle)
-	return commonCycle
+	return utils.NewIntAnswer(commonCycle)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
+	"strings"
 	"testing"
 )
 
@@ -50,6 +53,36 @@ func TestNode_Next(t *testing.T) {
 
 }
 
+func TestToDot(t *testing.T) {
+	nodes, err := parse(mockData2)
+	if err != nil {
+		t.Fatalf("parse - unexpected error: %v", err)
+	}
+
+	want := strings.Join([]string{
+		`digraph "circuit" {`,
+		`	"a" [label="%a", shape="box"];`,
+		`	"b" [label="%b", shape="box"];`,
+		`	"broadcaster" [shape="Mdiamond"];`,
+		`	"con" [label="&con", shape="invhouse"];`,
+		`	"end" [shape="doublecircle"];`,
+		`	"inv" [label="&inv", shape="invhouse"];`,
+		`	"a" -> "inv";`,
+		`	"a" -> "con";`,
+		`	"b" -> "con";`,
+		`	"broadcaster" -> "a";`,
+		`	"con" -> "end";`,
+		`	"end" -> "end";`,
+		`	"inv" -> "b";`,
+		`}`,
+		``,
+	}, "\n")
+
+	if got := toDot(nodes).String(); got != want {
+		t.Errorf("toDot - got\n%s\nwant\n%s", got, want)
+	}
+}
+
 func TestNode_AddPrev(t *testing.T) {
 	n := node{}
 	n.AddPrev(&flip{})
@@ -337,7 +370,7 @@ func TestPressButton(t *testing.T) {
 				t.Errorf("parse - unexpected error: %s", err)
 			}
 
-			nHigh, nLow := pressButton(n)
+			nHigh, nLow := pressButton(n, nil)
 
 			if nHigh != tc.wantHigh {
 				t.Errorf("pressButton - expected %d high signals, got %d", tc.wantHigh, nHigh)
@@ -370,7 +403,7 @@ func TestPressButtonN(t *testing.T) {
 				t.Errorf("parse - unexpected error: %s", err)
 			}
 
-			nHigh, nLow := pressButtonN(n, 1000)
+			nHigh, nLow := pressButtonN(n, 1000, nil)
 
 			if nHigh != tc.wantHigh {
 				t.Errorf("pressButton - expected %d high signals, got %d", tc.wantHigh, nHigh)
@@ -383,6 +416,110 @@ func TestPressButtonN(t *testing.T) {
 	}
 }
 
+func TestRecorder(t *testing.T) {
+	n, err := parse(mockData)
+	if err != nil {
+		t.Fatalf("parse - unexpected error: %s", err)
+	}
+
+	rec := &recorder{}
+	pressButtonN(n, 2, rec)
+
+	if got := len(rec.events); got != 24 {
+		t.Fatalf("recorder - expected 24 events, got %d", got)
+	}
+
+	first := rec.events[0]
+	if first != (event{press: 1, time: 0, from: "button", to: "broadcaster", high: false}) {
+		t.Errorf("recorder - unexpected first event %+v", first)
+	}
+
+	for i, e := range rec.events {
+		if e.time != i {
+			t.Errorf("recorder - expected event %d at time %d, got %d", i, i, e.time)
+		}
+	}
+
+	if got := len(rec.filter(func(e event) bool { return e.press == 2 })); got != 12 {
+		t.Errorf("recorder.filter - expected 12 events in the second press, got %d", got)
+	}
+
+	if got := len(rec.filter(func(e event) bool { return e.to == "inv" && e.high })); got != 2 {
+		t.Errorf("recorder.filter - expected 2 high signals to inv, got %d", got)
+	}
+}
+
+func TestRecorder_FirstPress(t *testing.T) {
+	n, err := parse(mockData2)
+	if err != nil {
+		t.Fatalf("parse - unexpected error: %s", err)
+	}
+
+	rec := &recorder{}
+	pressButtonN(n, 4, rec)
+
+	testCases := []struct {
+		from      string
+		high      bool
+		wantPress int
+		wantOk    bool
+	}{
+		{"a", true, 1, true},
+		{"a", false, 2, true},
+		{"broadcaster", true, 0, false},
+		{"end", false, 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s %v", tc.from, tc.high), func(t *testing.T) {
+			press, ok := rec.firstPress(tc.from, tc.high)
+			if press != tc.wantPress || ok != tc.wantOk {
+				t.Errorf("firstPress - expected %d, %v, got %d, %v", tc.wantPress, tc.wantOk, press, ok)
+			}
+		})
+	}
+}
+
+func TestRecorder_Nil(t *testing.T) {
+	var rec *recorder
+	rec.newPress()
+	rec.record("button", "broadcaster", false)
+
+	if rec != nil {
+		t.Errorf("recorder - nil recorder should stay nil")
+	}
+}
+
+func TestRecorder_VCD(t *testing.T) {
+	n, err := parse(mockData)
+	if err != nil {
+		t.Fatalf("parse - unexpected error: %s", err)
+	}
+
+	rec := &recorder{}
+	pressButtonN(n, 1, rec)
+
+	d, err := rec.vcd()
+	if err != nil {
+		t.Fatalf("vcd - unexpected error: %s", err)
+	}
+
+	dump := d.String()
+	for _, want := range []string{
+		"$var integer 32 ! press $end",
+		"$var wire 1 \" a $end",
+		"$var wire 1 $ broadcaster $end",
+		"$var wire 1 % button $end",
+		"$var wire 1 ' inv $end",
+		"$end\nb1 !\n0%\n#1\n0$\n",
+		"#11\n1'\n",
+	} {
+		if !strings.Contains(dump, want) {
+			t.Errorf("vcd - expected dump to contain %q, got\n%s", want, dump)
+		}
+	}
+}
+
 func TestProductHighLows(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -401,7 +538,7 @@ func TestProductHighLows(t *testing.T) {
 				t.Errorf("parse - unexpected error: %s", err)
 			}
 
-			got := productHighLows(n, 1000)
+			got := productHighLows(n, 1000, nil)
 
 			if got != tc.want {
 				t.Errorf("productHighLows - expected %d, got %d", tc.want, got)
@@ -441,19 +578,55 @@ func TestNPressUntil(t *testing.T) {
 }
 
 func TestPart1(t *testing.T) {
-	want := 806332748
+	want := utils.NewIntAnswer(806332748)
 	got := part1()
 
-	if got != want {
-		t.Errorf("part1 - expected %d, got %d", want, got)
+	if !got.Equal(want) {
+		t.Errorf("part1 - expected %v, got %v", want, got)
 	}
 }
 
 func TestPart2(t *testing.T) {
-	want := 228060006554227
+	want := utils.NewIntAnswer(228060006554227)
 	got := part2()
 
-	if got != want {
-		t.Errorf("part2 - expected %d, got %d", want, got)
+	if !got.Equal(want) {
+		t.Errorf("part2 - expected %v, got %v", want, got)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Add(strings.Join(mockData2, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parse(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	data := utils.ReadFile("input.txt")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Pressing the button changes the state of the nodes, so each run starts from a freshly parsed circuit
+		b.StopTimer()
+		n, err := parse(data)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+
+		productHighLows(n, 1000, nil)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	data := utils.ReadFile("input2.txt")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := pressesUntilRx(data); err != nil {
+			b.Fatal(err)
+		}
 	}
 }
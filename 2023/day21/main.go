@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"math"
-	"strings"
 
 	"github.com/iamlucasvieira/aoc/utils"
 )
@@ -13,53 +12,13 @@ type (
 	point = utils.Point
 )
 
-// neighbours is a function that returns the neighbours of a point in a grid.
-func neighbours(p point, g grid) []point {
-	neighbours := []point{
-		{X: p.X - 1, Y: p.Y},
-		{X: p.X + 1, Y: p.Y},
-		{X: p.X, Y: p.Y - 1},
-		{X: p.X, Y: p.Y + 1},
-	}
-
-	var validNeighbours []point
-
-	for _, n := range neighbours {
-
-		if !g.Contains(n) {
-			continue
-		}
-
-		if g.Get(n) != "#" {
-			validNeighbours = append(validNeighbours, n)
-		}
-	}
-
-	return validNeighbours
-}
-
-// neighboursInfinity is a function that returns the neighbours of a point in a grid.
-// If a point is outside the grid, the grid is repeated infinitely.
-func neighboursInfinity(p point, g grid) []point {
-	if p.X < 0 {
-		// Continues from right side
-		p.X = g.Width() - 1
-	}
-	return []point{}
+// isPlot is a function that checks if a tile of the garden can be stepped on.
+func isPlot(tile string) bool {
+	return tile != "#"
 }
 
 func parse(lines []string) (grid, error) {
-	var g grid
-	for _, line := range lines {
-		if line == "" {
-			continue
-		}
-
-		g = append(g, strings.Split(line, ""))
-
-	}
-
-	return g, nil
+	return utils.ParseGrid(lines, utils.Strings)
 }
 
 // findStart returns the start point of a grid.
@@ -75,36 +34,12 @@ func findStart(g grid) point {
 }
 
 // possibleEnd returns a list of possible end points after moving n steps from a point.
-func possibleEnd(start point, g grid, n int) []point {
-	type mapPoint map[point]bool
-	var queue = make(mapPoint)
-
-	queue[start] = true
-
-	for i := 0; i < n; i++ {
-		// Visit all points in the queue
-
-		var newQueue = make(mapPoint)
-
-		for k := range queue {
-			for _, n := range neighbours(k, g) {
-				newQueue[n] = true
-			}
-
-		}
-
-		queue = newQueue
-	}
-
-	var endPoints = make([]point, 0, len(queue))
-	for k := range queue {
-		endPoints = append(endPoints, k)
-	}
-
-	return endPoints
+// With a utils.TiledGrid the garden is repeated infinitely.
+func possibleEnd(start point, g utils.GridReader[string], n int) []point {
+	return utils.BFSWithin(g, []point{start}, isPlot, n).Exactly(n)
 }
 
-func part1() int {
+func part1() utils.Answer {
 	fmt.Println("Part 1:")
 	g, err := parse(utils.ReadFile("input.txt"))
 	if err != nil {
@@ -115,21 +50,16 @@ func part1() int {
 	endPoints := possibleEnd(start, g, 64)
 
 	fmt.Printf("There are %d possible end points after 64 steps.\n", len(endPoints))
-	return len(endPoints)
+	return utils.NewIntAnswer(len(endPoints))
 }
 
-func part2() {
-	fmt.Println("Part 2:")
-	var steps = 26501365
-
-	g, err := parse(utils.ReadFile("input2.txt"))
-	if err != nil {
-		panic(err)
-	}
-
+// reachableInfinite returns the number of points reached after exactly the given number of steps in the infinitely repeated garden.
+// It counts the points per copy of the garden instead of walking, which only works for a square garden with the start in
+// the middle and steps ending at the edge of a copy.
+func reachableInfinite(g grid, steps int) (int, error) {
 	// Assumption that the grid is square
 	if g.Width() != g.Height() {
-		panic("Grid is not square")
+		return 0, fmt.Errorf("grid is not square")
 	}
 
 	// Assumption that steps is equal
@@ -138,12 +68,17 @@ func part2() {
 
 	// Assumption that the start point is in the middle of the grid
 	if start.X != width/2 || start.Y != width/2 {
-		panic("Start point is not in the middle of the grid")
+		return 0, fmt.Errorf("start point is not in the middle of the grid")
 	}
 
 	// Assumption that the steps is equal to w * n + w/2
 	if steps%width != width/2 {
-		panic("Steps is not equal to w * n + w/2")
+		return 0, fmt.Errorf("steps is not equal to w * n + w/2")
+	}
+
+	// Assumption that n is even, the copies at the tips of the diamond are counted for an even n only
+	if steps/width%2 != 0 {
+		return 0, fmt.Errorf("steps is not equal to w * n + w/2 with an even n")
 	}
 
 	// Half Width of the diamond formed by the repeated grid
@@ -197,11 +132,28 @@ func part2() {
 	allPoints += len(segmentLargeBottomRight) * largeSegments
 	allPoints += len(segmentLargeTopLeft) * largeSegments
 	allPoints += len(segmentLargeBottomLeft) * largeSegments
-	fmt.Printf("There are %d points reached after %d steps.\n", allPoints, steps)
+	return allPoints, nil
+}
 
+func part2() utils.Answer {
+	fmt.Println("Part 2:")
+	var steps = 26501365
+
+	g, err := parse(utils.ReadFile("input2.txt"))
+	if err != nil {
+		panic(err)
+	}
+
+	allPoints, err := reachableInfinite(g, steps)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("There are %d points reached after %d steps.\n", allPoints, steps)
+	return utils.NewIntAnswer(allPoints)
 }
 
 func main() {
-	part1()
-	part2()
+	if err := utils.Report(part1(), part2()); err != nil {
+		panic(err)
+	}
 }
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
+	"strings"
 	"testing"
 )
 
@@ -37,37 +40,22 @@ func TestParse(t *testing.T) {
 	}
 }
 
-func TestNeighbours(t *testing.T) {
-	g, err := parse(mockData)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
-	}
-
+func TestIsPlot(t *testing.T) {
 	testCases := []struct {
-		p    point
-		want []point
+		tile string
+		want bool
 	}{
-		{
-			p:    point{X: 5, Y: 5},
-			want: []point{{4, 5}, {5, 4}},
-		}, {
-			p:    point{X: 4, Y: 2},
-			want: []point{{X: 4, Y: 1}},
-		},
+		{".", true},
+		{"S", true},
+		{"#", false},
 	}
 
 	for _, tc := range testCases {
-		got := neighbours(tc.p, g)
-
-		if len(got) != len(tc.want) {
-			t.Errorf("expected %d neighbours, got %d", len(tc.want), len(got))
-		}
-
-		for i := range got {
-			if got[i] != tc.want[i] {
-				t.Errorf("expected neighbour %d to be %v, got %v", i, tc.want[i], got[i])
+		t.Run(tc.tile, func(t *testing.T) {
+			if got := isPlot(tc.tile); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
 			}
-		}
+		})
 	}
 }
 
@@ -115,11 +103,123 @@ func TestPossibleEnd(t *testing.T) {
 	}
 }
 
+func TestPossibleEndTiled(t *testing.T) {
+	g, err := parse(mockData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		name string
+		n    int
+		want int
+	}{
+		{"Moving 6", 6, 16},
+		{"Moving 10", 10, 50},
+		{"Moving 50", 50, 1594},
+		{"Moving 100", 100, 6536},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := possibleEnd(findStart(g), utils.NewTiledGrid(g), tc.n)
+
+			if len(got) != tc.want {
+				t.Errorf("expected %d possible end points, got %d", tc.want, len(got))
+			}
+		})
+	}
+}
+
+func TestReachableInfinite(t *testing.T) {
+	g, err := parse(utils.ReadFile("input2.txt"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	width := g.Width()
+
+	// Counting the copies of the garden must agree with walking the repeated garden
+	for _, n := range []int{2, 4} {
+		steps := width*n + width/2
+		t.Run(fmt.Sprintf("%d steps", steps), func(t *testing.T) {
+			got, err := reachableInfinite(g, steps)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			want := len(possibleEnd(findStart(g), utils.NewTiledGrid(g), steps))
+			if got != want {
+				t.Errorf("expected %d possible end points, got %d", want, got)
+			}
+		})
+	}
+}
+
+func TestReachableInfiniteErrors(t *testing.T) {
+	g, err := parse(mockData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		name  string
+		g     grid
+		steps int
+	}{
+		{"Not square", g[:10], 16},
+		{"Start not in the middle", utils.NewGrid(11, 11, "."), 16},
+		{"Steps not ending at the edge", g, 10},
+		{"Odd number of copies", g, 16},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := reachableInfinite(tc.g, tc.steps); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
 func TestPart1(t *testing.T) {
-	want := 3740
+	want := utils.NewIntAnswer(3740)
 	got := part1()
 
-	if got != want {
-		t.Errorf("expected %d, got %d", want, got)
+	if !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	f.Add(strings.Join(mockData, "\n"))
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = parse(strings.Split(input, "\n"))
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	g, err := parse(utils.ReadFile("input.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	start := findStart(g)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		possibleEnd(start, g, 64)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	g, err := parse(utils.ReadFile("input2.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := reachableInfinite(g, 26501365); err != nil {
+			b.Fatal(err)
+		}
 	}
 }
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// answersFile is the file in each day's directory with the accepted answers, as written by utils.FormatAnswers.
+const answersFile = "answers.txt"
+
+// websiteURL is the address of the Advent of Code website, where answers are submitted.
+const websiteURL = "https://adventofcode.com"
+
+// sessionEnv is the environment variable with the session cookie of the Advent of Code website, used to submit answers.
+const sessionEnv = "AOC_SESSION"
+
+// tempAnswersFile creates an empty file for the solver to report its answers to, and returns its path.
+func tempAnswersFile() (string, error) {
+	f, err := os.CreateTemp("", "aoc-answers-*.txt")
+	if err != nil {
+		return "", err
+	}
+	return f.Name(), f.Close()
+}
+
+// readAnswers returns the answers in a file written by utils.Report or by hand.
+func readAnswers(path string) ([]utils.Answer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return utils.ParseAnswers(string(data))
+}
+
+// verifyAnswers compares the answers of the solver with the accepted answers in a file, and prints the result of each part.
+// It returns whether every accepted answer was given again.
+func verifyAnswers(path string, answers []utils.Answer) bool {
+	want, err := readAnswers(path)
+	if err != nil {
+		fmt.Printf("Cannot verify the answers: %v\n", err)
+		return false
+	}
+
+	ok := true
+	for i, w := range want {
+		var got utils.Answer
+		if i < len(answers) {
+			got = answers[i]
+		}
+
+		if got.Equal(w) {
+			fmt.Printf("Part %d: %s is correct\n", i+1, got)
+		} else {
+			fmt.Printf("Part %d: got %q, want %q\n", i+1, got, w)
+			ok = false
+		}
+	}
+	return ok
+}
+
+// articlePattern matches the message of the Advent of Code website about a submitted answer.
+var articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+
+// tagPattern matches the HTML tags inside the message.
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// newClient returns the HTTP client used to submit answers.
+func newClient() *http.Client {
+	return &http.Client{Timeout: 30 * time.Second}
+}
+
+// submitAnswer posts the answer of a part to the Advent of Code website at baseURL with client, and prints its reply.
+func submitAnswer(client *http.Client, baseURL, year, day string, part int, answers []utils.Answer) error {
+	if part < 1 || part > len(answers) || answers[part-1].IsEmpty() {
+		return fmt.Errorf("the solver has no answer for part %d", part)
+	}
+	session := os.Getenv(sessionEnv)
+	if session == "" {
+		return fmt.Errorf("%s is not set", sessionEnv)
+	}
+	dayNumber, err := strconv.Atoi(day)
+	if err != nil {
+		return fmt.Errorf("invalid day %q: %w", day, err)
+	}
+
+	answer := answers[part-1].String()
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	address := fmt.Sprintf("%s/%s/day/%d/answer", baseURL, year, dayNumber)
+
+	req, err := http.NewRequest(http.MethodPost, address, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", "github.com/iamlucasvieira/aoc")
+	req.AddCookie(&http.Cookie{Name: "session", Value: session})
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("the website replied %s", resp.Status)
+	}
+
+	match := articlePattern.FindSubmatch(body)
+	if match == nil {
+		return errors.New("the website reply has no message")
+	}
+	fmt.Printf("Submitted %q for part %d: %s\n", answer, part, strings.TrimSpace(tagPattern.ReplaceAllString(string(match[1]), "")))
+	return nil
+}
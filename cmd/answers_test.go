@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"github.com/iamlucasvieira/aoc/utils"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeAnswers writes an answers file to a temporary directory and returns its path.
+func writeAnswers(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), answersFile)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestReadAnswers(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		want    []utils.Answer
+		wantErr bool
+	}{
+		{"two parts", "\"42\"\n\"abc\"\n", []utils.Answer{utils.NewIntAnswer(42), utils.NewStringAnswer("abc")}, false},
+		{"empty", "", nil, false},
+		{"not quoted", "42\n", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := readAnswers(writeAnswers(t, tc.content))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("readAnswers() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("readAnswers() = %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tc.want[i]) {
+					t.Errorf("answer %d = %v, want %v", i+1, got[i], tc.want[i])
+				}
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		if _, err := readAnswers(filepath.Join(t.TempDir(), answersFile)); err == nil {
+			t.Errorf("readAnswers() expected an error for a missing file")
+		}
+	})
+}
+
+func TestVerifyAnswers(t *testing.T) {
+	accepted := "\"42\"\n\"7\"\n"
+
+	testCases := []struct {
+		name    string
+		path    func(t *testing.T) string
+		answers []utils.Answer
+		want    bool
+	}{
+		{"correct", func(t *testing.T) string { return writeAnswers(t, accepted) },
+			[]utils.Answer{utils.NewIntAnswer(42), utils.NewIntAnswer(7)}, true},
+		{"mismatched", func(t *testing.T) string { return writeAnswers(t, accepted) },
+			[]utils.Answer{utils.NewIntAnswer(42), utils.NewIntAnswer(8)}, false},
+		{"missing answer", func(t *testing.T) string { return writeAnswers(t, accepted) },
+			[]utils.Answer{utils.NewIntAnswer(42)}, false},
+		{"extra answer", func(t *testing.T) string { return writeAnswers(t, "\"42\"\n") },
+			[]utils.Answer{utils.NewIntAnswer(42), utils.NewIntAnswer(7)}, true},
+		{"missing answers file", func(t *testing.T) string { return filepath.Join(t.TempDir(), answersFile) },
+			[]utils.Answer{utils.NewIntAnswer(42), utils.NewIntAnswer(7)}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := verifyAnswers(tc.path(t), tc.answers); got != tc.want {
+				t.Errorf("verifyAnswers() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSubmitAnswer(t *testing.T) {
+	answers := []utils.Answer{utils.NewIntAnswer(42), {}}
+
+	testCases := []struct {
+		name    string
+		session string
+		day     string
+		part    int
+		status  int
+		reply   string
+		wantErr string
+	}{
+		{"accepted", "secret", "5", 1, http.StatusOK, "<main><article><p>That's the <em>right</em> answer!</p></article></main>", ""},
+		{"no answer for part", "secret", "5", 2, http.StatusOK, "", "no answer for part 2"},
+		{"part out of range", "secret", "5", 3, http.StatusOK, "", "no answer for part 3"},
+		{"no session", "", "5", 1, http.StatusOK, "", sessionEnv + " is not set"},
+		{"invalid day", "secret", "five", 1, http.StatusOK, "", "invalid day"},
+		{"error status", "secret", "5", 1, http.StatusBadRequest, "", "replied 400"},
+		{"no message", "secret", "5", 1, http.StatusOK, "<main></main>", "no message"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var submitted bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				submitted = true
+				if r.Method != http.MethodPost || r.URL.Path != "/2023/day/5/answer" {
+					t.Errorf("got %s %s, want POST /2023/day/5/answer", r.Method, r.URL.Path)
+				}
+				if cookie, err := r.Cookie("session"); err != nil || cookie.Value != tc.session {
+					t.Errorf("got session cookie %v, want %q", cookie, tc.session)
+				}
+				if r.FormValue("level") != "1" || r.FormValue("answer") != "42" {
+					t.Errorf("got form %v, want level 1 and answer 42", r.PostForm)
+				}
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.reply))
+			}))
+			defer server.Close()
+			t.Setenv(sessionEnv, tc.session)
+
+			err := submitAnswer(server.Client(), server.URL, "2023", tc.day, tc.part, answers)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("submitAnswer() error = %v", err)
+				}
+				if !submitted {
+					t.Errorf("submitAnswer() did not post the answer")
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("submitAnswer() error = %v, want one containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -2,16 +2,37 @@ package cmd
 
 import (
 	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
+	"github.com/iamlucasvieira/aoc/utils/dot"
+	"github.com/iamlucasvieira/aoc/utils/render"
+	"github.com/iamlucasvieira/aoc/utils/vcd"
 	"github.com/spf13/cobra"
 	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
 )
 
-var ToTest bool
+var (
+	ToTest     bool
+	Verbosity  int
+	RenderPath string
+	LiveRate   int
+	DotPath    string
+	VCDPath    string
+	ToVerify   bool
+	SubmitPart int
+)
 
 func init() {
 	rootCmd.PersistentFlags().BoolVarP(&ToTest, "test", "t", false, "Run tests")
+	rootCmd.PersistentFlags().CountVarP(&Verbosity, "verbose", "v", "Log solver info to stderr (-v), or debug too (-vv)")
+	rootCmd.PersistentFlags().StringVar(&RenderPath, "render", "", "Render solver frames to a .gif or .png path")
+	rootCmd.PersistentFlags().StringVar(&DotPath, "dot", "", "Write the puzzle graph in Graphviz DOT format to a path")
+	rootCmd.PersistentFlags().StringVar(&VCDPath, "vcd", "", "Write recorded signals as a Value Change Dump to a path")
+	rootCmd.PersistentFlags().IntVar(&LiveRate, "live", 0, "Draw solver frames in the terminal at this many frames per second")
+	rootCmd.PersistentFlags().BoolVar(&ToVerify, "verify", false, "Compare the answers with the ones in the day's "+answersFile)
+	rootCmd.PersistentFlags().IntVar(&SubmitPart, "submit", 0, "Submit the answer of this part, using the session cookie in "+sessionEnv)
 }
 
 var rootCmd = &cobra.Command{
@@ -42,25 +63,72 @@ var rootCmd = &cobra.Command{
 
 		fmt.Printf("Running the Advent of Code solutions for the year %s and day %s\n", year, day)
 
+		// Solvers write files with paths relative to where the runner was called
+		for _, path := range []*string{&RenderPath, &DotPath, &VCDPath} {
+			if *path == "" {
+				continue
+			}
+			absPath, err := filepath.Abs(*path)
+			if err != nil {
+				log.Fatalf("Invalid output path %s: %v", *path, err)
+			}
+			*path = absPath
+		}
+
+		// Solvers report their answers to a file, so they can be verified and submitted
+		answersPath, err := tempAnswersFile()
+		if err != nil {
+			log.Fatalf("Error creating the answers file: %v", err)
+		}
+
 		// Run the file
 		fmt.Printf("> go run %s\n", filePath)
-		executeCommand("go", "run", filePath)
+		executeCommand(answersPath, "go", "run", filePath)
+
+		answers, err := readAnswers(answersPath)
+		os.Remove(answersPath)
+		if err != nil {
+			log.Fatalf("Error reading the answers: %v", err)
+		}
+
+		if ToVerify {
+			if !verifyAnswers(filepath.Join(path, answersFile), answers) {
+				os.Exit(1)
+			}
+		}
+
+		if SubmitPart != 0 {
+			if err := submitAnswer(newClient(), websiteURL, year, day, SubmitPart, answers); err != nil {
+				log.Fatalf("Error submitting part %d: %v", SubmitPart, err)
+			}
+		}
 
 		if ToTest {
 			// Run the test file
 			fmt.Printf("> go test -v %s\n", path)
-			executeCommand("go", "test", "-v", path)
+			executeCommand("", "go", "test", "-v", path)
 		}
 	},
 }
 
-func executeCommand(command string, args ...string) {
+// executeCommand runs a command streaming its stdout and stderr separately, so solver logs never mix with answers.
+// The verbosity, output paths, live frame rate and answers file are passed to the solvers through the environment.
+func executeCommand(answersPath, command string, args ...string) {
 	cmd := exec.Command(command, args...)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		log.Fatalf("Error executing %s: %v\nOutput: %s", command, err, output)
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("%s=%d", utils.VerbosityEnv, Verbosity),
+		fmt.Sprintf("%s=%s", render.PathEnv, RenderPath),
+		fmt.Sprintf("%s=%d", render.LiveEnv, LiveRate),
+		fmt.Sprintf("%s=%s", dot.PathEnv, DotPath),
+		fmt.Sprintf("%s=%s", vcd.PathEnv, VCDPath),
+		fmt.Sprintf("%s=%s", utils.AnswersEnv, answersPath),
+	)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		log.Fatalf("Error executing %s: %v", command, err)
 	}
-	fmt.Printf("%s\n", output)
+	fmt.Println()
 }
 
 func Execute() {
@@ -0,0 +1,195 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// AnswersEnv is the environment variable the runner sets to the file solvers report their answers to.
+const AnswersEnv = "AOC_ANSWERS"
+
+// answerKind is the kind of value held by an Answer.
+type answerKind int
+
+const (
+	answerEmpty answerKind = iota
+	answerInt
+	answerBig
+	answerString
+	answerPixels
+)
+
+// Answer is the answer of a puzzle part. It holds an integer, a big integer, a string or a grid of pixels.
+// String returns the canonical form of the answer, which is used for submission and comparison.
+type Answer struct {
+	kind    answerKind
+	integer int64
+	big     *big.Int
+	text    string
+	pixels  Grid[bool]
+}
+
+// Integer is a constraint that permits any signed integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// NewIntAnswer is a function that returns an Answer holding an integer.
+func NewIntAnswer[T Integer](n T) Answer {
+	return Answer{kind: answerInt, integer: int64(n)}
+}
+
+// NewBigAnswer is a function that returns an Answer holding a copy of a big integer.
+func NewBigAnswer(n *big.Int) Answer {
+	if n == nil {
+		return Answer{}
+	}
+	return Answer{kind: answerBig, big: new(big.Int).Set(n)}
+}
+
+// NewStringAnswer is a function that returns an Answer holding a string.
+func NewStringAnswer(s string) Answer {
+	return Answer{kind: answerString, text: s}
+}
+
+// NewPixelAnswer is a function that returns an Answer holding a grid of pixels, where true is a lit pixel.
+func NewPixelAnswer(pixels Grid[bool]) Answer {
+	return Answer{kind: answerPixels, pixels: pixels}
+}
+
+// ParseAnswer is a function that returns the Answer represented by a string.
+// Integers that fit in an int64 become integer answers, larger ones become big integer answers
+// and everything else becomes a string answer.
+func ParseAnswer(s string) Answer {
+	s = strings.TrimSpace(s)
+
+	if s == "" {
+		return Answer{}
+	}
+
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return NewIntAnswer(n)
+	}
+
+	if n, ok := new(big.Int).SetString(s, 10); ok {
+		return NewBigAnswer(n)
+	}
+
+	return NewStringAnswer(s)
+}
+
+// IsEmpty is a method that checks if an Answer holds no value.
+func (a Answer) IsEmpty() bool {
+	return a.kind == answerEmpty
+}
+
+// Int is a method that returns the integer held by an Answer and whether it fits in an int64.
+func (a Answer) Int() (int64, bool) {
+	switch a.kind {
+	case answerInt:
+		return a.integer, true
+	case answerBig:
+		if a.big.IsInt64() {
+			return a.big.Int64(), true
+		}
+	}
+	return 0, false
+}
+
+// Pixels is a method that returns the grid of pixels held by an Answer.
+func (a Answer) Pixels() (Grid[bool], bool) {
+	return a.pixels, a.kind == answerPixels
+}
+
+// String is a method that returns the canonical string of an Answer, as it is submitted.
+// Pixel answers are read with OCR, or rendered one line per row when they are not letters.
+func (a Answer) String() string {
+	switch a.kind {
+	case answerInt:
+		return strconv.FormatInt(a.integer, 10)
+	case answerBig:
+		return a.big.String()
+	case answerString:
+		return strings.TrimSpace(a.text)
+	case answerPixels:
+		if text, err := OCR(a.pixels); err == nil {
+			return text
+		}
+		return a.Render()
+	}
+	return ""
+}
+
+// Render is a method that returns a representation of an Answer to be shown to a person.
+// Pixel answers are drawn with '#' for lit pixels and '.' for unlit pixels, other answers are their String.
+func (a Answer) Render() string {
+	if a.kind != answerPixels {
+		return a.String()
+	}
+
+	var sb strings.Builder
+	for y, row := range a.pixels {
+		if y > 0 {
+			sb.WriteByte('\n')
+		}
+		for _, lit := range row {
+			if lit {
+				sb.WriteByte('#')
+			} else {
+				sb.WriteByte('.')
+			}
+		}
+	}
+	return sb.String()
+}
+
+// Equal is a method that checks if an Answer is equal to another Answer.
+// Answers are equal when their canonical strings are equal, so an integer answer equals the same big integer answer.
+func (a Answer) Equal(other Answer) bool {
+	if a.kind == answerEmpty || other.kind == answerEmpty {
+		return a.kind == other.kind
+	}
+	return a.String() == other.String()
+}
+
+// Report is a function that reports the answers of the parts of a puzzle, in order, to the runner that verifies and submits them.
+// Answers are written to the file at AnswersEnv, and nothing is written when a solver runs on its own.
+func Report(answers ...Answer) error {
+	path := os.Getenv(AnswersEnv)
+	if path == "" {
+		return nil
+	}
+	return os.WriteFile(path, []byte(FormatAnswers(answers)), 0o644)
+}
+
+// FormatAnswers is a function that returns the canonical strings of answers, one quoted answer per line.
+// Quoting keeps answers rendered on several lines in a single line.
+func FormatAnswers(answers []Answer) string {
+	var sb strings.Builder
+	for _, a := range answers {
+		sb.WriteString(strconv.Quote(a.String()))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// ParseAnswers is a function that returns the answers written by FormatAnswers.
+// Blank lines are skipped, so answer files can be written by hand.
+func ParseAnswers(s string) ([]Answer, error) {
+	var answers []Answer
+	for i, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		text, err := strconv.Unquote(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: answer %s is not quoted: %w", i+1, line, err)
+		}
+		answers = append(answers, ParseAnswer(text))
+	}
+	return answers, nil
+}
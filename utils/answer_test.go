@@ -0,0 +1,198 @@
+package utils
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAnswer_String(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	testCases := []struct {
+		name   string
+		answer Answer
+		want   string
+	}{
+		{"int", NewIntAnswer(42), "42"},
+		{"negative int", NewIntAnswer(int64(-7)), "-7"},
+		{"big", NewBigAnswer(huge), "123456789012345678901234567890"},
+		{"string", NewStringAnswer("CMZ"), "CMZ"},
+		{"string with spaces", NewStringAnswer(" CMZ\n"), "CMZ"},
+		{"pixels", NewPixelAnswer(Grid[bool]{{true, false}, {false, true}}), "#.\n.#"},
+		{"empty", Answer{}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.answer.String(); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewBigAnswerCopies(t *testing.T) {
+	n := big.NewInt(10)
+	a := NewBigAnswer(n)
+	n.SetInt64(20)
+
+	if got := a.String(); got != "10" {
+		t.Errorf("got %q, want %q", got, "10")
+	}
+
+	if !NewBigAnswer(nil).IsEmpty() {
+		t.Errorf("NewBigAnswer(nil) should be empty")
+	}
+}
+
+func TestParseAnswer(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	testCases := []struct {
+		input string
+		want  Answer
+	}{
+		{"42", NewIntAnswer(42)},
+		{" 42\n", NewIntAnswer(42)},
+		{"123456789012345678901234567890", NewBigAnswer(huge)},
+		{"BSDMQFLSP", NewStringAnswer("BSDMQFLSP")},
+		{"", Answer{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			got := ParseAnswer(tc.input)
+			if !got.Equal(tc.want) || got.kind != tc.want.kind {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAnswer_Equal(t *testing.T) {
+	testCases := []struct {
+		name string
+		a, b Answer
+		want bool
+	}{
+		{"same int", NewIntAnswer(1), NewIntAnswer(int64(1)), true},
+		{"different int", NewIntAnswer(1), NewIntAnswer(2), false},
+		{"int and big", NewIntAnswer(5), NewBigAnswer(big.NewInt(5)), true},
+		{"int and string", NewIntAnswer(5), NewStringAnswer("5"), true},
+		{"strings", NewStringAnswer("ABC"), NewStringAnswer("ABD"), false},
+		{"empty", Answer{}, Answer{}, true},
+		{"empty and zero", Answer{}, NewIntAnswer(0), false},
+		{"pixels", NewPixelAnswer(Grid[bool]{{true}}), NewPixelAnswer(Grid[bool]{{true}}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.a.Equal(tc.b); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAnswer_Int(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	testCases := []struct {
+		name   string
+		answer Answer
+		want   int64
+		wantOk bool
+	}{
+		{"int", NewIntAnswer(3), 3, true},
+		{"small big", NewBigAnswer(big.NewInt(4)), 4, true},
+		{"huge big", NewBigAnswer(huge), 0, false},
+		{"string", NewStringAnswer("5"), 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := tc.answer.Int()
+			if got != tc.want || ok != tc.wantOk {
+				t.Errorf("got %v, %v, want %v, %v", got, ok, tc.want, tc.wantOk)
+			}
+		})
+	}
+}
+
+func TestAnswer_Pixels(t *testing.T) {
+	pixels := Grid[bool]{{true, false}}
+
+	if got, ok := NewPixelAnswer(pixels).Pixels(); !ok || got.Width() != 2 {
+		t.Errorf("got %v, %v, want %v, true", got, ok, pixels)
+	}
+
+	if _, ok := NewIntAnswer(1).Pixels(); ok {
+		t.Errorf("int answer should not have pixels")
+	}
+
+	if got := NewIntAnswer(1).Render(); got != "1" {
+		t.Errorf("got %q, want %q", got, "1")
+	}
+}
+
+func TestFormatAnswers(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	answers := []Answer{
+		NewIntAnswer(42),
+		NewBigAnswer(huge),
+		NewStringAnswer("CMZ"),
+		{},
+		NewPixelAnswer(Grid[bool]{{true, false}, {false, true}}),
+	}
+
+	s := FormatAnswers(answers)
+	if want := "\"42\"\n\"123456789012345678901234567890\"\n\"CMZ\"\n\"\"\n\"#.\\n.#\"\n"; s != want {
+		t.Errorf("FormatAnswers() = %q, want %q", s, want)
+	}
+
+	got, err := ParseAnswers(s)
+	if err != nil {
+		t.Fatalf("ParseAnswers() error = %v", err)
+	}
+	if len(got) != len(answers) {
+		t.Fatalf("ParseAnswers() returned %d answers, want %d", len(got), len(answers))
+	}
+	for i := range answers {
+		if !got[i].Equal(answers[i]) {
+			t.Errorf("answer %d = %q, want %q", i+1, got[i], answers[i])
+		}
+	}
+}
+
+func TestParseAnswersInvalid(t *testing.T) {
+	if _, err := ParseAnswers("\"1\"\n2\n"); err == nil {
+		t.Errorf("ParseAnswers() of an unquoted answer should return an error")
+	}
+}
+
+func TestReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers")
+
+	// Without the runner nothing is written
+	t.Setenv(AnswersEnv, "")
+	if err := Report(NewIntAnswer(1)); err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("Report() wrote answers without %s", AnswersEnv)
+	}
+
+	t.Setenv(AnswersEnv, path)
+	if err := Report(NewIntAnswer(1), NewStringAnswer("two")); err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), "\"1\"\n\"two\"\n"; got != want {
+		t.Errorf("Report() wrote %q, want %q", got, want)
+	}
+}
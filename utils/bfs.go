@@ -0,0 +1,138 @@
+package utils
+
+// Distances is a struct that holds the number of steps from the closest start to every point reached by a BFS.
+type Distances struct {
+	steps *SparseGrid[int]
+	max   int
+}
+
+// BFS is a function that explores a grid breadth-first from every start at once, moving to neighbours that share a side.
+// Only cells for which passable returns true are entered, and the starts are always reached.
+// On a TiledGrid the exploration never ends, so use BFSWithin instead.
+func BFS[T any](g GridReader[T], starts []Point, passable func(T) bool) *Distances {
+	return BFSWithin(g, starts, passable, -1)
+}
+
+// BFSWithin is a function that explores a grid like BFS, but stops after maxSteps steps.
+// A negative maxSteps explores without limit.
+func BFSWithin[T any](g GridReader[T], starts []Point, passable func(T) bool, maxSteps int) *Distances {
+	d := &Distances{steps: NewSparseGrid(-1)}
+
+	queue := make([]Point, 0, len(starts))
+	for _, s := range starts {
+		if _, ok := d.steps.Lookup(s); !ok {
+			d.steps.Set(s, 0)
+			queue = append(queue, s)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		steps := d.steps.Get(current)
+		d.max = max(d.max, steps)
+		if steps == maxSteps {
+			continue
+		}
+
+		for _, n := range current.Neighbours4(g) {
+			if _, ok := d.steps.Lookup(n); ok || !passable(g.Get(n)) {
+				continue
+			}
+			d.steps.Set(n, steps+1)
+			queue = append(queue, n)
+		}
+	}
+
+	return d
+}
+
+// Distance is a method that returns the number of steps to a point, and whether it was reached.
+func (d *Distances) Distance(p Point) (int, bool) {
+	return d.steps.Lookup(p)
+}
+
+// Max is a method that returns the number of steps to the furthest point reached.
+func (d *Distances) Max() int {
+	return d.max
+}
+
+// Len is a method that returns the number of points reached.
+func (d *Distances) Len() int {
+	return d.steps.Len()
+}
+
+// Points is a method that returns every point reached, in row-major order.
+func (d *Distances) Points() []Point {
+	return d.steps.Points()
+}
+
+// Within is a method that returns the points reached in at most n steps, in row-major order.
+func (d *Distances) Within(n int) []Point {
+	var points []Point
+	for p, steps := range d.steps.All() {
+		if steps <= n {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// Exactly is a method that returns the points where a walk of exactly n steps can end, in row-major order.
+// Stepping back and forth wastes two steps, so these are the points reached in at most n steps with the same parity as n.
+func (d *Distances) Exactly(n int) []Point {
+	var points []Point
+	for p, steps := range d.steps.All() {
+		if steps <= n && (n-steps)%2 == 0 {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// Grid is a method that returns the distances as a dense Grid covering the points reached, and the point its top left cell is at.
+// Points that were not reached hold -1.
+func (d *Distances) Grid() (Grid[int], Point) {
+	return d.steps.Grid()
+}
+
+// FloodFill is a function that returns the points of a Grid connected to start through passable cells that share a side.
+// The result is empty when start is outside the Grid or not passable.
+func FloodFill[T any](g Grid[T], start Point, passable func(T) bool) []Point {
+	if !g.Contains(start) || !passable(g.Get(start)) {
+		return nil
+	}
+	return BFS[T](g, []Point{start}, passable).Points()
+}
+
+// ConnectedComponents is a function that labels every cell of a Grid with the component it belongs to.
+// Cells that share a side are in the same component when connected returns true for their values.
+// Labels are numbered from 0 in row-major order of the first cell of each component, and the number of components is returned.
+func ConnectedComponents[T any](g Grid[T], connected func(a, b T) bool) (Grid[int], int) {
+	labels := NewGrid(g.Width(), g.Height(), -1)
+	var count int
+
+	for start := range g.All() {
+		if labels.Get(start) != -1 {
+			continue
+		}
+
+		labels.Set(start, count)
+		queue := []Point{start}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			for n, neighbourValue := range g.Neighbours(current) {
+				if labels.Get(n) == -1 && connected(g.Get(current), neighbourValue) {
+					labels.Set(n, count)
+					queue = append(queue, n)
+				}
+			}
+		}
+		count++
+	}
+
+	return labels, count
+}
@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+// isOpen is the passable function of the test mazes, where '#' is a wall.
+func isOpen(r rune) bool {
+	return r != '#'
+}
+
+func mustParseRunes(t *testing.T, lines []string) Grid[rune] {
+	t.Helper()
+	g, err := ParseGrid(lines, Runes)
+	if err != nil {
+		t.Fatalf("ParseGrid(%v) returned error: %v", lines, err)
+	}
+	return g
+}
+
+func TestBFS(t *testing.T) {
+	g := mustParseRunes(t, []string{
+		"..#.",
+		".##.",
+		"....",
+	})
+
+	d := BFS[rune](g, []Point{{0, 0}}, isOpen)
+
+	want := Grid[int]{
+		{0, 1, -1, 7},
+		{1, -1, -1, 6},
+		{2, 3, 4, 5},
+	}
+	got, offset := d.Grid()
+	if !reflect.DeepEqual(got, want) || offset != (Point{}) {
+		t.Errorf("got %v at %v, want %v", got, offset, want)
+	}
+
+	if d.Max() != 7 || d.Len() != 9 {
+		t.Errorf("Max() = %d, Len() = %d, want 7 and 9", d.Max(), d.Len())
+	}
+
+	if steps, ok := d.Distance(Point{2, 0}); ok {
+		t.Errorf("wall reached in %d steps", steps)
+	}
+}
+
+func TestBFS_MultiSource(t *testing.T) {
+	g := mustParseRunes(t, []string{"......."})
+
+	d := BFS[rune](g, []Point{{0, 0}, {6, 0}, {0, 0}}, isOpen)
+
+	want := Grid[int]{{0, 1, 2, 3, 2, 1, 0}}
+	if got, _ := d.Grid(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestBFSWithin(t *testing.T) {
+	g := mustParseRunes(t, []string{
+		".....",
+		".....",
+		".....",
+	})
+
+	d := BFSWithin[rune](g, []Point{{2, 1}}, isOpen, 1)
+
+	want := []Point{{2, 0}, {1, 1}, {2, 1}, {3, 1}, {2, 2}}
+	if got := d.Points(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestDistances_Queries(t *testing.T) {
+	g := mustParseRunes(t, []string{
+		".....",
+		".#...",
+		".....",
+	})
+	d := BFS[rune](g, []Point{{0, 0}}, isOpen)
+
+	testCases := []struct {
+		name string
+		got  []Point
+		want []Point
+	}{
+		{"within 1", d.Within(1), []Point{{0, 0}, {1, 0}, {0, 1}}},
+		{"exactly 1", d.Exactly(1), []Point{{1, 0}, {0, 1}}},
+		{"exactly 2", d.Exactly(2), []Point{{0, 0}, {2, 0}, {0, 2}}},
+		{"exactly 3", d.Exactly(3), []Point{{1, 0}, {3, 0}, {0, 1}, {2, 1}, {1, 2}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.got, tc.want) {
+				t.Errorf("got %v, want %v", tc.got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBFSWithin_Tiled(t *testing.T) {
+	g := mustParseRunes(t, []string{
+		"...",
+		".#.",
+		"...",
+	})
+
+	d := BFSWithin[rune](NewTiledGrid(g), []Point{{0, 0}}, isOpen, 4)
+
+	if got, ok := d.Distance(Point{-3, -1}); !ok || got != 4 {
+		t.Errorf("got %d, %v, want 4 steps to (-3, -1)", got, ok)
+	}
+	if _, ok := d.Distance(Point{-2, 1}); ok {
+		t.Errorf("wall of the tile to the left was reached")
+	}
+	if got := len(d.Exactly(2)); got != 8 {
+		t.Errorf("got %d points in exactly 2 steps, want 8", got)
+	}
+}
+
+func TestFloodFill(t *testing.T) {
+	g := mustParseRunes(t, []string{
+		"..#..",
+		"..#..",
+		"###..",
+	})
+
+	testCases := []struct {
+		name  string
+		start Point
+		want  []Point
+	}{
+		{"left room", Point{1, 1}, []Point{{0, 0}, {1, 0}, {0, 1}, {1, 1}}},
+		{"right room", Point{4, 2}, []Point{{3, 0}, {4, 0}, {3, 1}, {4, 1}, {3, 2}, {4, 2}}},
+		{"wall", Point{2, 0}, nil},
+		{"outside", Point{-1, 0}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := FloodFill(g, tc.start, isOpen); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConnectedComponents(t *testing.T) {
+	g := mustParseRunes(t, []string{
+		"AAB",
+		"ABB",
+		"CAA",
+	})
+
+	labels, count := ConnectedComponents(g, func(a, b rune) bool { return a == b })
+
+	want := Grid[int]{
+		{0, 0, 1},
+		{0, 1, 1},
+		{2, 3, 3},
+	}
+	if count != 4 || !reflect.DeepEqual(labels, want) {
+		t.Errorf("got %v with %d components, want %v with 4", labels, count, want)
+	}
+}
@@ -0,0 +1,84 @@
+package utils
+
+// Cycle is a struct that describes a sequence of states that ends up repeating itself.
+// The first Prefix states never come back, and from there the states repeat every Period steps.
+type Cycle struct {
+	Prefix, Period int
+}
+
+// FindCycle is a function that finds the Cycle of the states reached by calling step again and again from initial, with Brent's algorithm.
+// States are compared by their key, and step must return a new state instead of changing the one it is given.
+// Only a few states are kept at a time, and the sequence must repeat at some point or FindCycle never returns.
+func FindCycle[S any, K comparable](initial S, step func(S) S, key func(S) K) Cycle {
+	// Find the period, by moving the hare ahead of the tortoise in increasing powers of two
+	power, period := 1, 1
+	tortoise, hare := initial, step(initial)
+	for key(tortoise) != key(hare) {
+		if power == period {
+			tortoise = hare
+			power *= 2
+			period = 0
+		}
+		hare = step(hare)
+		period++
+	}
+
+	// Find the prefix, by moving both one step at a time with the hare a period ahead
+	tortoise, hare = initial, initial
+	for i := 0; i < period; i++ {
+		hare = step(hare)
+	}
+	var prefix int
+	for key(tortoise) != key(hare) {
+		tortoise, hare = step(tortoise), step(hare)
+		prefix++
+	}
+
+	return Cycle{Prefix: prefix, Period: period}
+}
+
+// FindCycleFloyd is a function that finds the same Cycle as FindCycle, with Floyd's tortoise and hare algorithm.
+// It usually calls step more often than FindCycle, but is the textbook algorithm to compare against.
+func FindCycleFloyd[S any, K comparable](initial S, step func(S) S, key func(S) K) Cycle {
+	// The hare moves twice as fast as the tortoise, so they meet inside the cycle
+	tortoise, hare := step(initial), step(step(initial))
+	for key(tortoise) != key(hare) {
+		tortoise, hare = step(tortoise), step(step(hare))
+	}
+
+	// The distance from the start to the cycle is the distance from the meeting point to the cycle
+	var prefix int
+	tortoise = initial
+	for key(tortoise) != key(hare) {
+		tortoise, hare = step(tortoise), step(hare)
+		prefix++
+	}
+
+	period := 1
+	hare = step(tortoise)
+	for key(tortoise) != key(hare) {
+		hare = step(hare)
+		period++
+	}
+
+	return Cycle{Prefix: prefix, Period: period}
+}
+
+// Index is a method that returns the smallest number of steps that reaches the same state as n steps.
+// The result is less than Prefix + Period, so it can index the states seen while finding the Cycle.
+func (c Cycle) Index(n int) int {
+	if n < c.Prefix || c.Period <= 0 {
+		return n
+	}
+	return c.Prefix + (n-c.Prefix)%c.Period
+}
+
+// StateAt is a function that returns the state after n steps from initial, skipping the repetitions of its Cycle.
+// At most Prefix + Period steps are taken, whatever the size of n.
+func StateAt[S any](initial S, step func(S) S, c Cycle, n int) S {
+	state := initial
+	for i := c.Index(n); i > 0; i-- {
+		state = step(state)
+	}
+	return state
+}
@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+)
+
+// rho is a function that returns a step going through prefix states and then a loop of period states, like the letter ρ.
+func rho(prefix, period int) func(int) int {
+	return func(i int) int {
+		if i+1 == prefix+period {
+			return prefix
+		}
+		return i + 1
+	}
+}
+
+// identity is a function that returns its argument, to use states as their own keys.
+func identity[T any](x T) T {
+	return x
+}
+
+func TestFindCycle(t *testing.T) {
+	testCases := []struct {
+		name string
+		step func(int) int
+		want Cycle
+	}{
+		{"fixed point", rho(0, 1), Cycle{0, 1}},
+		{"pure loop", rho(0, 7), Cycle{0, 7}},
+		{"prefix to fixed point", rho(5, 1), Cycle{5, 1}},
+		{"short prefix", rho(1, 10), Cycle{1, 10}},
+		{"long prefix", rho(100, 3), Cycle{100, 3}},
+		{"power of two", rho(16, 32), Cycle{16, 32}},
+		// The Cycle of this one is not known in advance
+		{"pollard", func(x int) int { return (x*x + 1) % 1000 }, Cycle{}},
+	}
+
+	for _, tc := range testCases {
+		// Check against the first repeated state, found by remembering every state
+		want := tc.want
+		if want == (Cycle{}) {
+			seen := make(map[int]int)
+			x := 0
+			for i := 0; ; i++ {
+				if first, ok := seen[x]; ok {
+					want = Cycle{first, i - first}
+					break
+				}
+				seen[x] = i
+				x = tc.step(x)
+			}
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			if got := FindCycle(0, tc.step, identity[int]); got != want {
+				t.Errorf("FindCycle() = %+v, want %+v", got, want)
+			}
+			if got := FindCycleFloyd(0, tc.step, identity[int]); got != want {
+				t.Errorf("FindCycleFloyd() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestFindCycleKey(t *testing.T) {
+	// States are slices, which are compared by the string of their values
+	step := func(s []int) []int {
+		return []int{s[1], (s[0] + s[1]) % 10}
+	}
+	key := func(s []int) string {
+		return fmt.Sprint(s)
+	}
+
+	// The last digits of the Fibonacci numbers repeat every 60 numbers
+	want := Cycle{0, 60}
+	if got := FindCycle([]int{0, 1}, step, key); got != want {
+		t.Errorf("FindCycle() = %+v, want %+v", got, want)
+	}
+	if got := FindCycleFloyd([]int{0, 1}, step, key); got != want {
+		t.Errorf("FindCycleFloyd() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCycle_Index(t *testing.T) {
+	c := Cycle{Prefix: 3, Period: 4}
+
+	testCases := []struct {
+		n    int
+		want int
+	}{
+		{0, 0},
+		{2, 2},
+		{3, 3},
+		{6, 6},
+		{7, 3},
+		{8, 4},
+		{10, 6},
+		{11, 3},
+		{1000000000, 3 + (1000000000-3)%4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.n), func(t *testing.T) {
+			if got := c.Index(tc.n); got != tc.want {
+				t.Errorf("Index(%d) = %d, want %d", tc.n, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStateAt(t *testing.T) {
+	step := rho(7, 5)
+	c := FindCycle(0, step, identity[int])
+
+	// Every remainder of the period must land on the same state as stepping all the way
+	for n := 0; n < 40; n++ {
+		want := 0
+		for i := 0; i < n; i++ {
+			want = step(want)
+		}
+
+		if got := StateAt(0, step, c, n); got != want {
+			t.Errorf("StateAt(%d) = %d, want %d", n, got, want)
+		}
+	}
+
+	var calls int
+	counted := func(i int) int {
+		calls++
+		return step(i)
+	}
+	if got, want := StateAt(0, counted, c, 1000000000), 7+(1000000000-7)%5; got != want {
+		t.Errorf("StateAt(1000000000) = %d, want %d", got, want)
+	}
+	if calls >= c.Prefix+c.Period {
+		t.Errorf("StateAt took %d steps, want less than %d", calls, c.Prefix+c.Period)
+	}
+}
+
+func BenchmarkFindCycle(b *testing.B) {
+	step := rho(1000, 1000)
+
+	b.Run("brent", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			FindCycle(0, step, identity[int])
+		}
+	})
+	b.Run("floyd", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			FindCycleFloyd(0, step, identity[int])
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Direction is a struct that represents a step to a neighbouring point in a grid, where y grows downwards.
+type Direction struct {
+	X, Y int
+}
+
+var (
+	Up        = Direction{0, -1}
+	UpRight   = Direction{1, -1}
+	Right     = Direction{1, 0}
+	DownRight = Direction{1, 1}
+	Down      = Direction{0, 1}
+	DownLeft  = Direction{-1, 1}
+	Left      = Direction{-1, 0}
+	UpLeft    = Direction{-1, -1}
+)
+
+// Directions4 are the directions of the neighbours that share a side with a point, clockwise from Up.
+var Directions4 = []Direction{Up, Right, Down, Left}
+
+// Directions8 are the directions of all neighbours of a point, including diagonals, clockwise from Up.
+var Directions8 = []Direction{Up, UpRight, Right, DownRight, Down, DownLeft, Left, UpLeft}
+
+// directionNames are the names of the directions, used by String.
+var directionNames = map[Direction]string{
+	Up:        "up",
+	UpRight:   "up-right",
+	Right:     "right",
+	DownRight: "down-right",
+	Down:      "down",
+	DownLeft:  "down-left",
+	Left:      "left",
+	UpLeft:    "up-left",
+}
+
+// directionSymbols are the ways a direction is written in puzzle inputs.
+var directionSymbols = map[string]Direction{
+	"U": Up, "R": Right, "D": Down, "L": Left,
+	"^": Up, ">": Right, "V": Down, "<": Left,
+	"N": Up, "E": Right, "S": Down, "W": Left,
+	"NE": UpRight, "SE": DownRight, "SW": DownLeft, "NW": UpLeft,
+	"UP": Up, "RIGHT": Right, "DOWN": Down, "LEFT": Left,
+}
+
+// ParseDirection is a function that returns the direction written as U/D/L/R, ^/v/</>, or a compass point such as N or SW.
+// Letters are not case sensitive.
+func ParseDirection(s string) (Direction, error) {
+	d, ok := directionSymbols[strings.ToUpper(s)]
+	if !ok {
+		return Direction{}, fmt.Errorf("%q is not a direction", s)
+	}
+	return d, nil
+}
+
+// UnmarshalText is a method that sets a direction from its text, as accepted by ParseDirection.
+func (d *Direction) UnmarshalText(text []byte) error {
+	parsed, err := ParseDirection(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// String is a method that returns the name of a direction, or its offset if it is not a neighbour.
+func (d Direction) String() string {
+	if name, ok := directionNames[d]; ok {
+		return name
+	}
+	return d.Point().String()
+}
+
+// Point is a method that returns the offset of a direction as a point.
+func (d Direction) Point() Point {
+	return Point{d.X, d.Y}
+}
+
+// TurnRight is a method that returns the direction a quarter turn clockwise.
+func (d Direction) TurnRight() Direction {
+	return Direction{-d.Y, d.X}
+}
+
+// TurnLeft is a method that returns the direction a quarter turn counterclockwise.
+func (d Direction) TurnLeft() Direction {
+	return Direction{d.Y, -d.X}
+}
+
+// Opposite is a method that returns the direction pointing the other way.
+func (d Direction) Opposite() Direction {
+	return Direction{-d.X, -d.Y}
+}
+
+// Reflect is a method that returns the direction after bouncing off a mirror, either '/' or '\\'.
+// Any other rune does not change the direction.
+func (d Direction) Reflect(mirror rune) Direction {
+	switch mirror {
+	case '/':
+		return Direction{-d.Y, -d.X}
+	case '\\':
+		return Direction{d.Y, d.X}
+	}
+	return d
+}
+
+// IsHorizontal is a method that checks if a direction moves only left or right.
+func (d Direction) IsHorizontal() bool {
+	return d.Y == 0 && d.X != 0
+}
+
+// IsVertical is a method that checks if a direction moves only up or down.
+func (d Direction) IsVertical() bool {
+	return d.X == 0 && d.Y != 0
+}
+
+// Move is a method that returns the point one step from a point in a direction.
+func (p Point) Move(d Direction) Point {
+	return Point{p.X + d.X, p.Y + d.Y}
+}
+
+// Neighbours4 is a method that returns the neighbours of a point that share a side with it, clockwise from the one above.
+// Neighbours outside bounds are left out, and a nil bounds keeps all of them.
+func (p Point) Neighbours4(bounds GridInterface) []Point {
+	return p.neighbours(Directions4, bounds)
+}
+
+// Neighbours8 is a method that returns all neighbours of a point, including diagonals, clockwise from the one above.
+// Neighbours outside bounds are left out, and a nil bounds keeps all of them.
+func (p Point) Neighbours8(bounds GridInterface) []Point {
+	return p.neighbours(Directions8, bounds)
+}
+
+// neighbours is a method that returns the points one step from a point in each direction that are inside bounds.
+func (p Point) neighbours(directions []Direction, bounds GridInterface) []Point {
+	neighbours := make([]Point, 0, len(directions))
+	for _, d := range directions {
+		n := p.Move(d)
+		if bounds == nil || bounds.Contains(n) {
+			neighbours = append(neighbours, n)
+		}
+	}
+	return neighbours
+}
@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDirection_Turns(t *testing.T) {
+	testCases := []struct {
+		d                        Direction
+		right, left, opposite    Direction
+		slash, backslash         Direction
+		isHorizontal, isVertical bool
+	}{
+		{Up, Right, Left, Down, Right, Left, false, true},
+		{Right, Down, Up, Left, Up, Down, true, false},
+		{Down, Left, Right, Up, Left, Right, false, true},
+		{Left, Up, Down, Right, Down, Up, true, false},
+		{UpRight, DownRight, UpLeft, DownLeft, UpRight, DownLeft, false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.d.String(), func(t *testing.T) {
+			if got := tc.d.TurnRight(); got != tc.right {
+				t.Errorf("TurnRight() = %v, want %v", got, tc.right)
+			}
+			if got := tc.d.TurnLeft(); got != tc.left {
+				t.Errorf("TurnLeft() = %v, want %v", got, tc.left)
+			}
+			if got := tc.d.Opposite(); got != tc.opposite {
+				t.Errorf("Opposite() = %v, want %v", got, tc.opposite)
+			}
+			if got := tc.d.Reflect('/'); got != tc.slash {
+				t.Errorf("Reflect('/') = %v, want %v", got, tc.slash)
+			}
+			if got := tc.d.Reflect('\\'); got != tc.backslash {
+				t.Errorf("Reflect('\\\\') = %v, want %v", got, tc.backslash)
+			}
+			if got := tc.d.Reflect('|'); got != tc.d {
+				t.Errorf("Reflect('|') = %v, want %v", got, tc.d)
+			}
+			if tc.d.IsHorizontal() != tc.isHorizontal || tc.d.IsVertical() != tc.isVertical {
+				t.Errorf("IsHorizontal() = %v, IsVertical() = %v", tc.d.IsHorizontal(), tc.d.IsVertical())
+			}
+		})
+	}
+}
+
+func TestDirection_TurnsRoundTrip(t *testing.T) {
+	for _, d := range Directions8 {
+		if got := d.TurnRight().TurnLeft(); got != d {
+			t.Errorf("%v turned right and left is %v", d, got)
+		}
+		if got := d.TurnRight().TurnRight(); got != d.Opposite() {
+			t.Errorf("%v turned right twice is %v, want %v", d, got, d.Opposite())
+		}
+		if got := d.Reflect('/').Reflect('/'); got != d {
+			t.Errorf("%v reflected twice is %v", d, got)
+		}
+	}
+}
+
+func TestParseDirection(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  Direction
+	}{
+		{"U", Up}, {"D", Down}, {"L", Left}, {"R", Right},
+		{"^", Up}, {"v", Down}, {"<", Left}, {">", Right},
+		{"N", Up}, {"S", Down}, {"W", Left}, {"E", Right},
+		{"NE", UpRight}, {"se", DownRight}, {"SW", DownLeft}, {"NW", UpLeft},
+		{"u", Up}, {"left", Left},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ParseDirection(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "X", "UU", "north"} {
+		if _, err := ParseDirection(input); err == nil {
+			t.Errorf("ParseDirection(%q) should return an error", input)
+		}
+	}
+}
+
+func TestDirection_UnmarshalText(t *testing.T) {
+	var d Direction
+	if err := d.UnmarshalText([]byte("<")); err != nil || d != Left {
+		t.Errorf("got %v, %v, want %v", d, err, Left)
+	}
+	if err := d.UnmarshalText([]byte("?")); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestDirection_String(t *testing.T) {
+	if got := DownLeft.String(); got != "down-left" {
+		t.Errorf("got %q, want %q", got, "down-left")
+	}
+	if got := (Direction{2, 0}).String(); got != (Point{2, 0}).String() {
+		t.Errorf("got %q, want the offset", got)
+	}
+}
+
+func TestPoint_Neighbours(t *testing.T) {
+	g := NewGrid(3, 3, 0)
+
+	testCases := []struct {
+		name string
+		got  []Point
+		want []Point
+	}{
+		{"4 in the middle", Point{1, 1}.Neighbours4(g), []Point{{1, 0}, {2, 1}, {1, 2}, {0, 1}}},
+		{"4 in a corner", Point{0, 0}.Neighbours4(g), []Point{{1, 0}, {0, 1}}},
+		{"4 unbounded", Point{0, 0}.Neighbours4(nil), []Point{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}},
+		{"8 in a corner", Point{2, 2}.Neighbours8(g), []Point{{2, 1}, {1, 2}, {1, 1}}},
+		{"8 in the middle", Point{1, 1}.Neighbours8(g), []Point{{1, 0}, {2, 0}, {2, 1}, {2, 2}, {1, 2}, {0, 2}, {0, 1}, {0, 0}}},
+		{"4 tiled", Point{0, 0}.Neighbours4(NewTiledGrid(g)), []Point{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.got, tc.want) {
+				t.Errorf("got %v, want %v", tc.got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPoint_Move(t *testing.T) {
+	if got := (Point{2, 3}).Move(UpLeft); got != (Point{1, 2}) {
+		t.Errorf("got %v, want %v", got, Point{1, 2})
+	}
+}
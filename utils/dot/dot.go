@@ -0,0 +1,180 @@
+// Package dot builds Graphviz DOT descriptions of graphs, to be drawn with tools like dot -Tsvg.
+package dot
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// PathEnv is the environment variable the runner uses to pass the --dot output path to the solvers.
+const PathEnv = "AOC_DOT"
+
+// OutputPath is a function that returns the path a solver writes its graph to, and whether it was requested.
+func OutputPath() (string, bool) {
+	path := os.Getenv(PathEnv)
+	return path, path != ""
+}
+
+// Attrs are the attributes of a graph element, such as its label or shape.
+type Attrs map[string]string
+
+// Node is a node of a graph.
+type Node struct {
+	ID    string
+	Attrs Attrs
+}
+
+// Label is a method that sets the text shown in the node.
+func (n *Node) Label(label string) *Node {
+	return n.Attr("label", label)
+}
+
+// Shape is a method that sets the shape of the node, e.g. box, circle or diamond.
+func (n *Node) Shape(shape string) *Node {
+	return n.Attr("shape", shape)
+}
+
+// Color is a method that sets the colour of the node outline.
+func (n *Node) Color(color string) *Node {
+	return n.Attr("color", color)
+}
+
+// Attr is a method that sets an attribute of the node.
+func (n *Node) Attr(key, value string) *Node {
+	n.Attrs[key] = value
+	return n
+}
+
+// Edge is an edge between two nodes of a graph.
+type Edge struct {
+	From, To string
+	Attrs    Attrs
+}
+
+// Label is a method that sets the text shown next to the edge.
+func (e *Edge) Label(label string) *Edge {
+	return e.Attr("label", label)
+}
+
+// Color is a method that sets the colour of the edge.
+func (e *Edge) Color(color string) *Edge {
+	return e.Attr("color", color)
+}
+
+// Attr is a method that sets an attribute of the edge.
+func (e *Edge) Attr(key, value string) *Edge {
+	e.Attrs[key] = value
+	return e
+}
+
+// Graph is a directed or undirected graph. Nodes and edges are written in the order they are added.
+type Graph struct {
+	Name     string
+	Directed bool
+	Attrs    Attrs
+	nodes    []*Node
+	index    map[string]*Node
+	edges    []*Edge
+}
+
+// NewDigraph is a function that returns an empty directed graph.
+func NewDigraph(name string) *Graph {
+	return &Graph{Name: name, Directed: true, Attrs: Attrs{}, index: make(map[string]*Node)}
+}
+
+// NewGraph is a function that returns an empty undirected graph.
+func NewGraph(name string) *Graph {
+	return &Graph{Name: name, Attrs: Attrs{}, index: make(map[string]*Node)}
+}
+
+// Node is a method that returns the node with an id, adding it to the graph if it does not exist yet.
+func (g *Graph) Node(id string) *Node {
+	if n, ok := g.index[id]; ok {
+		return n
+	}
+	n := &Node{ID: id, Attrs: Attrs{}}
+	g.nodes = append(g.nodes, n)
+	g.index[id] = n
+	return n
+}
+
+// Edge is a method that adds an edge between two nodes, adding the nodes if they do not exist yet.
+func (g *Graph) Edge(from, to string) *Edge {
+	g.Node(from)
+	g.Node(to)
+	e := &Edge{From: from, To: to, Attrs: Attrs{}}
+	g.edges = append(g.edges, e)
+	return e
+}
+
+// Nodes is a method that returns the nodes of the graph.
+func (g *Graph) Nodes() []*Node {
+	return g.nodes
+}
+
+// Edges is a method that returns the edges of the graph.
+func (g *Graph) Edges() []*Edge {
+	return g.edges
+}
+
+// quoteReplacer escapes backslashes, quotes and newlines, all in a single pass so no escape is escaped again.
+var quoteReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// quote is a function that returns a string as a DOT quoted identifier.
+func quote(s string) string {
+	return `"` + quoteReplacer.Replace(s) + `"`
+}
+
+// attrsString is a function that returns the attributes in DOT syntax, sorted by key.
+func attrsString(attrs Attrs) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = fmt.Sprintf("%s=%s", k, quote(attrs[k]))
+	}
+	return " [" + strings.Join(pairs, ", ") + "]"
+}
+
+// String is a method that returns the graph in DOT syntax.
+func (g *Graph) String() string {
+	kind, arrow := "graph", "--"
+	if g.Directed {
+		kind, arrow = "digraph", "->"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s {\n", kind, quote(g.Name))
+	if len(g.Attrs) > 0 {
+		fmt.Fprintf(&sb, "\tgraph%s;\n", attrsString(g.Attrs))
+	}
+	for _, n := range g.nodes {
+		fmt.Fprintf(&sb, "\t%s%s;\n", quote(n.ID), attrsString(n.Attrs))
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(&sb, "\t%s %s %s%s;\n", quote(e.From), arrow, quote(e.To), attrsString(e.Attrs))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// WriteTo is a method that writes the graph in DOT syntax to w.
+func (g *Graph) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, g.String())
+	return int64(n), err
+}
+
+// Save is a method that writes the graph in DOT syntax to a file.
+func (g *Graph) Save(path string) error {
+	return os.WriteFile(path, []byte(g.String()), 0o644)
+}
@@ -0,0 +1,99 @@
+package dot
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGraph_String(t *testing.T) {
+	testCases := []struct {
+		name  string
+		graph func() *Graph
+		want  string
+	}{
+		{"empty digraph", func() *Graph { return NewDigraph("g") }, "digraph \"g\" {\n}\n"},
+		{"undirected", func() *Graph {
+			g := NewGraph("g")
+			g.Edge("a", "b")
+			return g
+		}, "graph \"g\" {\n\t\"a\";\n\t\"b\";\n\t\"a\" -- \"b\";\n}\n"},
+		{"attributes", func() *Graph {
+			g := NewDigraph("g")
+			g.Attrs["rankdir"] = "LR"
+			g.Node("a").Shape("box").Label("start")
+			g.Edge("a", "b").Label("x>1").Color("red")
+			return g
+		}, "digraph \"g\" {\n\tgraph [rankdir=\"LR\"];\n\t\"a\" [label=\"start\", shape=\"box\"];\n\t\"b\";\n\t\"a\" -> \"b\" [color=\"red\", label=\"x>1\"];\n}\n"},
+		{"quoting", func() *Graph {
+			g := NewDigraph("g")
+			g.Node(`say "hi"`).Label("two\nlines")
+			return g
+		}, "digraph \"g\" {\n\t\"say \\\"hi\\\"\" [label=\"two\\nlines\"];\n}\n"},
+		{"backslashes", func() *Graph {
+			g := NewDigraph("g")
+			g.Node(`C:\dir\`).Label(`a\"b`)
+			return g
+		}, "digraph \"g\" {\n\t\"C:\\\\dir\\\\\" [label=\"a\\\\\\\"b\"];\n}\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.graph().String(); got != tc.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGraph_Node(t *testing.T) {
+	g := NewDigraph("g")
+	a := g.Node("a").Color("blue")
+	g.Edge("b", "a")
+
+	if g.Node("a") != a {
+		t.Errorf("Node should return the existing node")
+	}
+	if len(g.Nodes()) != 2 || g.Nodes()[0].ID != "a" || g.Nodes()[1].ID != "b" {
+		t.Errorf("nodes should be a, b in insertion order")
+	}
+	if len(g.Edges()) != 1 {
+		t.Errorf("got %d edges, want 1", len(g.Edges()))
+	}
+}
+
+func TestGraph_WriteTo(t *testing.T) {
+	g := NewDigraph("g")
+	g.Edge("a", "b")
+
+	var buf bytes.Buffer
+	n, err := g.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != g.String() || int(n) != buf.Len() {
+		t.Errorf("WriteTo wrote %d bytes %q, want %q", n, buf.String(), g.String())
+	}
+
+	path := filepath.Join(t.TempDir(), "g.dot")
+	if err := g.Save(path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != g.String() {
+		t.Errorf("saved %q, %v, want %q", data, err, g.String())
+	}
+}
+
+func TestOutputPath(t *testing.T) {
+	t.Setenv(PathEnv, "")
+	if _, ok := OutputPath(); ok {
+		t.Errorf("no path should be requested")
+	}
+
+	t.Setenv(PathEnv, "out.dot")
+	if path, ok := OutputPath(); !ok || path != "out.dot" {
+		t.Errorf("got %q, %v, want out.dot, true", path, ok)
+	}
+}
@@ -0,0 +1,86 @@
+package geom
+
+import "math/big"
+
+// BigPoint is a struct that represents a point of a grid with arbitrarily large coordinates.
+type BigPoint struct {
+	X, Y *big.Int
+}
+
+// BigPolygon is a Polygon whose vertices have arbitrarily large coordinates.
+// Its measures are exact however large the polygon is, where those of a Polygon would overflow.
+type BigPolygon []BigPoint
+
+// Big is a method that returns the BigPolygon with the same vertices as a Polygon.
+func (p Polygon) Big() BigPolygon {
+	b := make(BigPolygon, len(p))
+	for i, v := range p {
+		b[i] = BigPoint{big.NewInt(int64(v.X)), big.NewInt(int64(v.Y))}
+	}
+	return b
+}
+
+// edges is a method that calls fn with every edge of a BigPolygon, from a vertex to the next one.
+func (p BigPolygon) edges(fn func(a, b BigPoint)) {
+	for i := range p {
+		fn(p[i], p[(i+1)%len(p)])
+	}
+}
+
+// DoubleArea is a method that returns twice the signed area of a BigPolygon, with the shoelace formula.
+// It is positive for Clockwise polygons and negative for CounterClockwise ones.
+func (p BigPolygon) DoubleArea() *big.Int {
+	area := new(big.Int)
+	var ab, ba big.Int
+	p.edges(func(a, b BigPoint) {
+		ab.Mul(a.X, b.Y)
+		ba.Mul(b.X, a.Y)
+		area.Add(area, ab.Sub(&ab, &ba))
+	})
+	return area
+}
+
+// Winding is a method that returns the order in which the vertices go around a BigPolygon.
+func (p BigPolygon) Winding() Winding {
+	switch p.DoubleArea().Sign() {
+	case 1:
+		return Clockwise
+	case -1:
+		return CounterClockwise
+	}
+	return Degenerate
+}
+
+// BoundaryPoints is a method that returns the number of grid points on the edges of a BigPolygon.
+func (p BigPolygon) BoundaryPoints() *big.Int {
+	points := new(big.Int)
+	var dx, dy, gcd big.Int
+	p.edges(func(a, b BigPoint) {
+		dx.Abs(dx.Sub(b.X, a.X))
+		dy.Abs(dy.Sub(b.Y, a.Y))
+		switch {
+		case dx.Sign() == 0:
+			points.Add(points, &dy)
+		case dy.Sign() == 0:
+			points.Add(points, &dx)
+		default:
+			points.Add(points, gcd.GCD(nil, nil, &dx, &dy))
+		}
+	})
+	return points
+}
+
+// InteriorPoints is a method that returns the number of grid points strictly inside a BigPolygon, with Pick's theorem.
+// The BigPolygon must not cross itself.
+func (p BigPolygon) InteriorPoints() *big.Int {
+	interior := new(big.Int).Abs(p.DoubleArea())
+	interior.Sub(interior, p.BoundaryPoints())
+	interior.Add(interior, big.NewInt(2))
+	return interior.Rsh(interior, 1)
+}
+
+// LatticePoints is a method that returns the number of grid points inside or on the edges of a BigPolygon.
+func (p BigPolygon) LatticePoints() *big.Int {
+	points := p.InteriorPoints()
+	return points.Add(points, p.BoundaryPoints())
+}
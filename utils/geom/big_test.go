@@ -0,0 +1,47 @@
+package geom
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBigPolygon_AgreesWithPolygon(t *testing.T) {
+	polygons := []Polygon{square, reversed(square), triangle, uShape}
+
+	for _, p := range polygons {
+		b := p.Big()
+		if got := b.DoubleArea(); got.Cmp(big.NewInt(int64(p.DoubleArea()))) != 0 {
+			t.Errorf("%v: DoubleArea() = %v, want %d", p, got, p.DoubleArea())
+		}
+		if got := b.BoundaryPoints(); got.Cmp(big.NewInt(int64(p.BoundaryPoints()))) != 0 {
+			t.Errorf("%v: BoundaryPoints() = %v, want %d", p, got, p.BoundaryPoints())
+		}
+		if got := b.LatticePoints(); got.Cmp(big.NewInt(int64(p.LatticePoints()))) != 0 {
+			t.Errorf("%v: LatticePoints() = %v, want %d", p, got, p.LatticePoints())
+		}
+		if got := b.Winding(); got != p.Winding() {
+			t.Errorf("%v: Winding() = %v, want %v", p, got, p.Winding())
+		}
+	}
+}
+
+func TestBigPolygon_Huge(t *testing.T) {
+	// A square with side 2^40, whose area overflows an int64
+	side := new(big.Int).Lsh(big.NewInt(1), 40)
+	zero := new(big.Int)
+	p := BigPolygon{{zero, zero}, {side, zero}, {side, side}, {zero, side}}
+
+	// (2^40 + 1)^2 points, from a square of 2^40 + 1 points per side
+	n := new(big.Int).Add(side, big.NewInt(1))
+	want := new(big.Int).Mul(n, n)
+
+	if got := p.LatticePoints(); got.Cmp(want) != 0 {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	wantInterior := new(big.Int).Sub(side, big.NewInt(1))
+	wantInterior.Mul(wantInterior, wantInterior)
+	if got := p.InteriorPoints(); got.Cmp(wantInterior) != 0 {
+		t.Errorf("got %v interior points, want %v", got, wantInterior)
+	}
+}
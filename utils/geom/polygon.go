@@ -0,0 +1,229 @@
+// Package geom measures and fills polygons whose vertices are points of a grid.
+package geom
+
+import (
+	"cmp"
+	"github.com/iamlucasvieira/aoc/utils"
+	"math"
+	"slices"
+)
+
+// Polygon is a closed polygon given by its vertices in order, where the last vertex connects back to the first.
+// Vertices are points of a grid, so y grows downwards.
+type Polygon []utils.Point
+
+// Winding is the order in which the vertices of a Polygon go around it.
+type Winding int
+
+const (
+	// Degenerate is the winding of a Polygon without area.
+	Degenerate Winding = iota
+	// Clockwise is the winding of a Polygon that turns clockwise when drawn on a grid.
+	Clockwise
+	// CounterClockwise is the winding of a Polygon that turns counterclockwise when drawn on a grid.
+	CounterClockwise
+)
+
+// Location is where a point of a grid is with respect to a Polygon.
+type Location int
+
+const (
+	Outside Location = iota
+	Boundary
+	Inside
+)
+
+// String is a method that returns the name of a Location.
+func (l Location) String() string {
+	switch l {
+	case Boundary:
+		return "boundary"
+	case Inside:
+		return "inside"
+	}
+	return "outside"
+}
+
+// Outline is a function that returns the Polygon traced by starting at start and walking each step in turn.
+// Vertices are only added where the walk turns, so long walks in a straight line stay small.
+func Outline(start utils.Point, steps []utils.Point) Polygon {
+	polygon := Polygon{start}
+	current := start
+	for _, step := range steps {
+		if step == (utils.Point{}) {
+			continue
+		}
+		current = current.Add(step)
+		polygon = append(polygon, current)
+	}
+
+	// The walk usually ends where it started, which is already the first vertex
+	if len(polygon) > 1 && polygon[len(polygon)-1] == start {
+		polygon = polygon[:len(polygon)-1]
+	}
+	return polygon.simplify()
+}
+
+// simplify is a method that returns the Polygon without vertices in the middle of a straight edge.
+func (p Polygon) simplify() Polygon {
+	simple := make(Polygon, 0, len(p))
+	for i, v := range p {
+		previous, next := p[(i+len(p)-1)%len(p)], p[(i+1)%len(p)]
+		if len(p) > 2 && cross(previous, v, next) == 0 && dot(previous, v, next) <= 0 {
+			continue
+		}
+		simple = append(simple, v)
+	}
+	return simple
+}
+
+// edges is a method that calls fn with every edge of a Polygon, from a vertex to the next one.
+func (p Polygon) edges(fn func(a, b utils.Point)) {
+	for i := range p {
+		fn(p[i], p[(i+1)%len(p)])
+	}
+}
+
+// DoubleArea is a method that returns twice the signed area of a Polygon, with the shoelace formula.
+// Twice the area of a Polygon with vertices on a grid is always an integer.
+// It is positive for Clockwise polygons and negative for CounterClockwise ones.
+func (p Polygon) DoubleArea() int {
+	var area int
+	p.edges(func(a, b utils.Point) {
+		area += a.X*b.Y - b.X*a.Y
+	})
+	return area
+}
+
+// Area is a method that returns the area of a Polygon.
+func (p Polygon) Area() float64 {
+	return float64(utils.Abs(p.DoubleArea())) / 2
+}
+
+// Winding is a method that returns the order in which the vertices go around a Polygon.
+func (p Polygon) Winding() Winding {
+	switch area := p.DoubleArea(); {
+	case area > 0:
+		return Clockwise
+	case area < 0:
+		return CounterClockwise
+	}
+	return Degenerate
+}
+
+// Perimeter is a method that returns the length of the edges of a Polygon.
+func (p Polygon) Perimeter() float64 {
+	var perimeter float64
+	p.edges(func(a, b utils.Point) {
+		perimeter += math.Hypot(float64(b.X-a.X), float64(b.Y-a.Y))
+	})
+	return perimeter
+}
+
+// BoundaryPoints is a method that returns the number of grid points on the edges of a Polygon.
+// For a Polygon with only horizontal and vertical edges this is its perimeter.
+func (p Polygon) BoundaryPoints() int {
+	var points int
+	p.edges(func(a, b utils.Point) {
+		points += utils.GCD(utils.Abs(b.X-a.X), utils.Abs(b.Y-a.Y))
+	})
+	return points
+}
+
+// InteriorPoints is a method that returns the number of grid points strictly inside a Polygon, with Pick's theorem.
+// The Polygon must not cross itself.
+func (p Polygon) InteriorPoints() int {
+	return (utils.Abs(p.DoubleArea()) - p.BoundaryPoints() + 2) / 2
+}
+
+// LatticePoints is a method that returns the number of grid points inside or on the edges of a Polygon.
+func (p Polygon) LatticePoints() int {
+	return p.InteriorPoints() + p.BoundaryPoints()
+}
+
+// Locate is a method that returns where a point is with respect to a Polygon.
+func (p Polygon) Locate(q utils.Point) Location {
+	g := p.Fill(utils.NewRect(q.X, q.Y, 1, 1))
+	return g[0][0]
+}
+
+// crossing is where an edge of a Polygon crosses a row of the grid, at x = num / den.
+type crossing struct {
+	num, den int
+}
+
+// Fill is a method that classifies every point of a grid inside bounds as Inside, Outside or on the Boundary of a Polygon.
+// Each row is scanned once, so the Polygon is not tested again for every point.
+// Cell (x, y) of the result is point (bounds.Min.X + x, bounds.Min.Y + y).
+func (p Polygon) Fill(bounds utils.Rect) utils.Grid[Location] {
+	g := utils.NewGrid(bounds.Width(), bounds.Height(), Outside)
+
+	for row := range g {
+		y := bounds.Min.Y + row
+		var crossings []crossing
+
+		p.edges(func(a, b utils.Point) {
+			p.markBoundary(g[row], bounds.Min.X, y, a, b)
+
+			// Edges include their top end and exclude their bottom end, so vertices are not counted twice
+			if (a.Y <= y) == (b.Y <= y) {
+				return
+			}
+			if a.Y > b.Y {
+				a, b = b, a
+			}
+			den := b.Y - a.Y
+			crossings = append(crossings, crossing{a.X*den + (y-a.Y)*(b.X-a.X), den})
+		})
+
+		slices.SortFunc(crossings, func(c, d crossing) int {
+			return cmp.Compare(c.num*d.den, d.num*c.den)
+		})
+
+		// Points with an odd number of crossings to their left are inside
+		var left int
+		for col := range g[row] {
+			x := bounds.Min.X + col
+			for left < len(crossings) && crossings[left].num < x*crossings[left].den {
+				left++
+			}
+			if g[row][col] != Boundary && left%2 == 1 {
+				g[row][col] = Inside
+			}
+		}
+	}
+	return g
+}
+
+// markBoundary is a method that marks the points of row y that are on the edge from a to b, where the row starts at minX.
+func (p Polygon) markBoundary(row []Location, minX, y int, a, b utils.Point) {
+	if y < min(a.Y, b.Y) || y > max(a.Y, b.Y) {
+		return
+	}
+
+	if a.Y == b.Y {
+		for x := max(min(a.X, b.X), minX); x <= max(a.X, b.X) && x-minX < len(row); x++ {
+			row[x-minX] = Boundary
+		}
+		return
+	}
+
+	// The edge is on a grid point of this row only if its x is an integer
+	num, den := a.X*(b.Y-a.Y)+(y-a.Y)*(b.X-a.X), b.Y-a.Y
+	if num%den != 0 {
+		return
+	}
+	if x := num / den; x >= minX && x-minX < len(row) {
+		row[x-minX] = Boundary
+	}
+}
+
+// cross is a function that returns the z component of the cross product of the vectors a to b and b to c.
+func cross(a, b, c utils.Point) int {
+	return (b.X-a.X)*(c.Y-b.Y) - (b.Y-a.Y)*(c.X-b.X)
+}
+
+// dot is a function that returns the dot product of the vectors b to a and b to c.
+func dot(a, b, c utils.Point) int {
+	return (a.X-b.X)*(c.X-b.X) + (a.Y-b.Y)*(c.Y-b.Y)
+}
@@ -0,0 +1,187 @@
+package geom
+
+import (
+	"github.com/iamlucasvieira/aoc/utils"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Test polygons, drawn with y growing downwards
+var (
+	square   = Polygon{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}}
+	triangle = Polygon{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 0, Y: 3}}
+	uShape   = Polygon{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 4, Y: 4}, {X: 3, Y: 4}, {X: 3, Y: 1}, {X: 1, Y: 1}, {X: 1, Y: 4}, {X: 0, Y: 4}}
+)
+
+// reversed is a function that returns a polygon with its vertices in the opposite order.
+func reversed(p Polygon) Polygon {
+	r := make(Polygon, len(p))
+	for i, v := range p {
+		r[len(p)-1-i] = v
+	}
+	return r
+}
+
+func TestPolygon_Measures(t *testing.T) {
+	testCases := []struct {
+		name      string
+		polygon   Polygon
+		area2     int
+		winding   Winding
+		perimeter float64
+		boundary  int
+		interior  int
+	}{
+		{"square", square, 8, Clockwise, 8, 8, 1},
+		{"square reversed", reversed(square), -8, CounterClockwise, 8, 8, 1},
+		{"triangle", triangle, 12, Clockwise, 12, 8, 3},
+		{"u shape", uShape, 20, Clockwise, 22, 22, 0},
+		{"line", Polygon{{X: 0, Y: 0}, {X: 3, Y: 0}}, 0, Degenerate, 6, 6, -2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.polygon.DoubleArea(); got != tc.area2 {
+				t.Errorf("DoubleArea() = %d, want %d", got, tc.area2)
+			}
+			if got := tc.polygon.Area(); got != math.Abs(float64(tc.area2))/2 {
+				t.Errorf("Area() = %v, want %v", got, math.Abs(float64(tc.area2))/2)
+			}
+			if got := tc.polygon.Winding(); got != tc.winding {
+				t.Errorf("Winding() = %v, want %v", got, tc.winding)
+			}
+			if got := tc.polygon.Perimeter(); math.Abs(got-tc.perimeter) > 1e-9 {
+				t.Errorf("Perimeter() = %v, want %v", got, tc.perimeter)
+			}
+			if got := tc.polygon.BoundaryPoints(); got != tc.boundary {
+				t.Errorf("BoundaryPoints() = %d, want %d", got, tc.boundary)
+			}
+			if tc.winding == Degenerate {
+				return
+			}
+			if got := tc.polygon.InteriorPoints(); got != tc.interior {
+				t.Errorf("InteriorPoints() = %d, want %d", got, tc.interior)
+			}
+			if got := tc.polygon.LatticePoints(); got != tc.interior+tc.boundary {
+				t.Errorf("LatticePoints() = %d, want %d", got, tc.interior+tc.boundary)
+			}
+		})
+	}
+}
+
+func TestOutline(t *testing.T) {
+	steps := []utils.Point{{X: 2, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 2}, {X: 0, Y: 0}, {X: -3, Y: 0}, {X: 0, Y: -1}, {X: 0, Y: -1}}
+
+	want := Polygon{{X: 1, Y: 1}, {X: 4, Y: 1}, {X: 4, Y: 3}, {X: 1, Y: 3}}
+	if got := Outline(utils.Point{X: 1, Y: 1}, steps); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// drawLocations is a function that draws a filled grid with B for the boundary, I inside and . outside.
+func drawLocations(g utils.Grid[Location]) []string {
+	symbols := map[Location]string{Outside: ".", Boundary: "B", Inside: "I"}
+	var lines []string
+	for _, row := range g {
+		var sb strings.Builder
+		for _, l := range row {
+			sb.WriteString(symbols[l])
+		}
+		lines = append(lines, sb.String())
+	}
+	return lines
+}
+
+func TestPolygon_Fill(t *testing.T) {
+	testCases := []struct {
+		name    string
+		polygon Polygon
+		bounds  utils.Rect
+		want    []string
+	}{
+		{"triangle", triangle, utils.NewRect(0, 0, 5, 4), []string{
+			"BBBBB",
+			"BII..",
+			"BI...",
+			"B....",
+		}},
+		{"u shape", uShape, utils.NewRect(-1, -1, 7, 7), []string{
+			".......",
+			".BBBBB.",
+			".BBBBB.",
+			".BB.BB.",
+			".BB.BB.",
+			".BB.BB.",
+			".......",
+		}},
+		{"big square", Polygon{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 4, Y: 3}, {X: 0, Y: 3}}, utils.NewRect(1, 1, 5, 3), []string{
+			"IIIB.",
+			"IIIB.",
+			"BBBB.",
+		}},
+		{"diamond", Polygon{{X: 2, Y: 0}, {X: 4, Y: 2}, {X: 2, Y: 4}, {X: 0, Y: 2}}, utils.NewRect(0, 0, 5, 5), []string{
+			"..B..",
+			".BIB.",
+			"BIIIB",
+			".BIB.",
+			"..B..",
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := drawLocations(tc.polygon.Fill(tc.bounds))
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tc.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestPolygon_FillAgreesWithPick(t *testing.T) {
+	polygons := []Polygon{square, triangle, uShape, reversed(uShape),
+		{{X: 0, Y: 0}, {X: 7, Y: 2}, {X: 3, Y: 9}, {X: -4, Y: 5}},
+		{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}, {X: 6, Y: 10}, {X: 6, Y: 4}, {X: 4, Y: 4}, {X: 4, Y: 10}, {X: 0, Y: 10}},
+	}
+
+	for _, p := range polygons {
+		var inside, boundary int
+		for _, row := range p.Fill(utils.NewRect(-5, -5, 20, 20)) {
+			for _, l := range row {
+				switch l {
+				case Inside:
+					inside++
+				case Boundary:
+					boundary++
+				}
+			}
+		}
+
+		if inside != p.InteriorPoints() || boundary != p.BoundaryPoints() {
+			t.Errorf("%v: filled %d inside and %d boundary, want %d and %d", p, inside, boundary, p.InteriorPoints(), p.BoundaryPoints())
+		}
+	}
+}
+
+func TestPolygon_Locate(t *testing.T) {
+	testCases := []struct {
+		p    utils.Point
+		want Location
+	}{
+		{utils.Point{X: 1, Y: 1}, Inside},
+		{utils.Point{X: 2, Y: 1}, Boundary},
+		{utils.Point{X: 0, Y: 0}, Boundary},
+		{utils.Point{X: 3, Y: 1}, Outside},
+		{utils.Point{X: -1, Y: 1}, Outside},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.p.String(), func(t *testing.T) {
+			if got := square.Locate(tc.p); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
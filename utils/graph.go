@@ -49,25 +49,16 @@ func (n *Node) String() string {
 	return n.Point.String()
 }
 
+// neighborDirections are the directions Neighbors looks at, in order.
+var neighborDirections = []Direction{Up, Down, Left, Right}
+
 // Neighbors is a function that returns the neighbors of a node in a graph.
+// Neighbors are returned up, down, left and right of the node.
 func Neighbors(n *Node, g Graph[*Node]) []*Node {
-	directions := []Point{
-		{0, -1}, // up
-		{0, 1},  // down
-		{-1, 0}, // left
-		{1, 0},  // right
-	}
-
 	neighbors := make([]*Node, 0)
 
-	for _, direction := range directions {
-		neighbor := n.Add(direction)
-		contains := g.Nodes.Contains(neighbor)
-		prev := n.Prev
-		if prev != nil {
-			contains = contains && !neighbor.Equal(prev.Point)
-		}
-		if contains {
+	for _, neighbor := range n.neighbours(neighborDirections, g.Nodes) {
+		if n.Prev == nil || !neighbor.Equal(n.Prev.Point) {
 			neighbour := g.Get(neighbor.X, neighbor.Y)
 			neighbors = append(neighbors, &Node{
 				Point: neighbour.Point,
@@ -433,3 +433,20 @@ func TestNode_String(t *testing.T) {
 		t.Fatalf("n.String() should be (0, 0), but is %s", n.String())
 	}
 }
+
+func BenchmarkDijkstraTemplate(b *testing.B) {
+	// A 100x100 grid with weights between 1 and 9
+	input := make(Grid[int], 100)
+	for y := range input {
+		input[y] = make([]int, 100)
+		for x := range input[y] {
+			input[y][x] = (x*y+x+y)%9 + 1
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g := NewGraph(input)
+		DijkstraTemplate[*Node](g, g.Get(0, 0), g.Get(99, 99), Neighbors)
+	}
+}
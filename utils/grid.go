@@ -2,7 +2,8 @@ package utils
 
 import (
 	"fmt"
-	"math"
+	"slices"
+	"strings"
 )
 
 // GridInterface is an interface that represents a Grid.
@@ -55,69 +56,167 @@ func NewGrid[T any](width, height int, defaultValue T) Grid[T] {
 	return g
 }
 
-// InsidePolygon is a function that checks if a point is inside a polygon that is inside a grid.
-func InsidePolygon(p Point, g GridInterface, polygon []Point) (bool, error) {
-	// Define the count of crossings
-	var nCrossing int
+// ParseGrid is a function that returns the Grid of the characters in lines, mapping each character with mapper.
+// Empty lines are skipped, and all other lines must have the same number of characters.
+func ParseGrid[T any](lines []string, mapper func(rune, Point) (T, error)) (Grid[T], error) {
+	g, _, err := ParseGridMarkers(lines, mapper, "")
+	return g, err
+}
+
+// ParseGridMarkers is a function that parses a Grid like ParseGrid and also returns the positions of
+// every character in markers, such as the start 'S'. Markers are still given to mapper.
+func ParseGridMarkers[T any](lines []string, mapper func(rune, Point) (T, error), markers string) (Grid[T], map[rune][]Point, error) {
+	var g Grid[T]
+	found := make(map[rune][]Point)
+
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
 
-	// Define the struct that represents a point in the polygon
-	type polygonPoint struct {
-		previous Point
-		next     Point
+		chars := []rune(line)
+		if len(g) > 0 && len(chars) != g.Width() {
+			return nil, nil, fmt.Errorf("row %d has %d columns, expected %d", len(g), len(chars), g.Width())
+		}
+
+		row := make([]T, len(chars))
+		for x, char := range chars {
+			p := Point{x, len(g)}
+			value, err := mapper(char, p)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%v: %w", p, err)
+			}
+			row[x] = value
+
+			if strings.ContainsRune(markers, char) {
+				found[char] = append(found[char], p)
+			}
+		}
+		g = append(g, row)
 	}
 
+	return g, found, nil
+}
+
+// Runes is a grid mapper that keeps each character as a rune.
+func Runes(r rune, _ Point) (rune, error) {
+	return r, nil
+}
+
+// Strings is a grid mapper that keeps each character as a string.
+func Strings(r rune, _ Point) (string, error) {
+	return string(r), nil
+}
+
+// Digits is a grid mapper that returns the value of a decimal digit.
+func Digits(r rune, _ Point) (int, error) {
+	if r < '0' || r > '9' {
+		return 0, fmt.Errorf("%q is not a digit", r)
+	}
+	return int(r - '0'), nil
+}
+
+// Bools is a grid mapper that returns true for '#' and false for '.'.
+func Bools(r rune, _ Point) (bool, error) {
+	switch r {
+	case '#':
+		return true, nil
+	case '.':
+		return false, nil
+	}
+	return false, fmt.Errorf("%q is not '#' or '.'", r)
+}
+
+// InsidePolygon is a function that checks if a point is inside a polygon that is inside a grid.
+// To check many points of the same polygon, prepare it once with NewPolygonIndex instead.
+func InsidePolygon(p Point, g GridInterface, polygon []Point) (bool, error) {
 	// Check if point is in grid
 	if !g.Contains(p) {
 		return false, fmt.Errorf("point %v is outside grid", p)
 	}
 
-	// Check if polygon starting and final point are the same
-	if !polygon[0].Equal(polygon[len(polygon)-1]) {
-		return false, fmt.Errorf("polygon start point is not equal the ending point")
+	index, err := NewPolygonIndex(polygon)
+	if err != nil {
+		return false, err
 	}
 
-	// Create a map that contains the polygon points
-	polygonMap := make(map[Point]polygonPoint)
-	for i := 1; i < len(polygon)-1; i++ {
-		previous, current, next := polygon[i-1], polygon[i], polygon[i+1]
-		polygonMap[current] = polygonPoint{previous, next}
-
+	for _, current := range polygon {
 		if !g.Contains(current) {
 			return false, fmt.Errorf("point %v of polygon is outside grid", current)
 		}
 	}
 
-	// Check if the point is in the polygon // So at the edge of the polygon
-	if _, ok := polygonMap[p]; ok {
-		return false, nil
+	return index.Contains(p), nil
+}
+
+// PolygonIndex is a struct that holds a polygon prepared to check which points of a grid are inside it.
+// The polygon is a closed path of points where each point is next to the previous one, such as a loop through a grid.
+// Points of the path itself are not inside the polygon.
+type PolygonIndex struct {
+	// path holds the x of the points of the path in each row, sorted
+	path map[int][]int
+	// crossings holds the x of the points of the path in each row that connect to the point above, sorted
+	crossings map[int][]int
+}
+
+// NewPolygonIndex is a function that prepares a polygon, so checking a point does not go through the whole polygon again.
+// The polygon must end with the point it starts with.
+func NewPolygonIndex(polygon []Point) (*PolygonIndex, error) {
+	// Check if polygon starting and final point are the same
+	if len(polygon) == 0 || !polygon[0].Equal(polygon[len(polygon)-1]) {
+		return nil, fmt.Errorf("polygon start point is not equal the ending point")
 	}
 
-	for x := p.X + 1; x < g.Width(); x++ {
-		current := Point{x, p.Y}
+	index := &PolygonIndex{path: make(map[int][]int), crossings: make(map[int][]int)}
 
-		neighbours, ok := polygonMap[current]
+	// The last point repeats the first one, so the first point connects to the one before the last
+	n := len(polygon) - 1
+	for i := 0; i < n; i++ {
+		previous, current, next := polygon[(i+n-1)%n], polygon[i], polygon[(i+1)%n]
+		index.path[current.Y] = append(index.path[current.Y], current.X)
 
-		// If the point is not in the polygon, continue
-		if !ok {
-			continue
+		// A ray along the row crosses the path where it goes up, while moving along the row does not cross it
+		if current.Y == previous.Y+1 || current.Y == next.Y+1 {
+			index.crossings[current.Y] = append(index.crossings[current.Y], current.X)
 		}
+	}
 
-		// true if the current point in the polygon is immediately above the previous (Forming a corner)
-		abovePrevious := current.Y == neighbours.previous.Y+1
-
-		// true if the current point in the polygon is immediately above the next (Forming a corner)
-		aboveNext := current.Y == neighbours.next.Y+1
+	for _, xs := range index.path {
+		slices.Sort(xs)
+	}
+	for _, xs := range index.crossings {
+		slices.Sort(xs)
+	}
+	return index, nil
+}
 
-		// If the current point is not above the previous or the next, we have a horizontal line
-		if !abovePrevious && !aboveNext {
-			continue
-		}
+// Contains is a method that checks if a point is inside the polygon, in logarithmic time.
+func (pi *PolygonIndex) Contains(p Point) bool {
+	if _, onPath := slices.BinarySearch(pi.path[p.Y], p.X); onPath {
+		return false
+	}
 
-		nCrossing++
+	// If even number of crossings to the right of the point, it is outside
+	crossings := pi.crossings[p.Y]
+	left, _ := slices.BinarySearch(crossings, p.X)
+	return (len(crossings)-left)%2 == 1
+}
 
+// CountInside is a method that returns the number of points inside the polygon, in linear time on the size of each row.
+func (pi *PolygonIndex) CountInside() int {
+	var count int
+	for y, crossings := range pi.crossings {
+		path := pi.path[y]
+
+		// Points between a crossing and the next one are inside, unless they are on the path
+		for i := 0; i+1 < len(crossings); i += 2 {
+			from, to := crossings[i], crossings[i+1]
+			first, _ := slices.BinarySearch(path, from+1)
+			last, _ := slices.BinarySearch(path, to)
+			count += to - from - 1 - (last - first)
+		}
 	}
-	// If even number of crossings, point is outside
-	return nCrossing%2 == 1, nil
+	return count
 }
 
 // Element is a struct that represents an element in a Grid.
@@ -128,19 +227,10 @@ type Element[T any] struct {
 
 // Iterator returns a channel that iterates over the Grid.
 // Elements are sent through the channel along with their coordinates.
+//
+// Deprecated: Iterator starts a goroutine that leaks when the loop stops early. Use All instead.
 func (g Grid[T]) Iterator() <-chan Element[T] {
-	ch := make(chan Element[T])
-
-	go func() {
-		defer close(ch)
-		for y, row := range g {
-			for x, item := range row {
-				ch <- Element[T]{item, Point{x, y}}
-			}
-		}
-	}()
-
-	return ch
+	return elements(g.All())
 }
 
 // Get is a method that returns the value of a point in a Grid.
@@ -192,7 +282,12 @@ func (p Point) Mul(p2 Point) Point {
 	}
 }
 
-// Distance is a method that returns the distance between two points.
+// Vector is a method that returns the coordinates of a point as a Vector.
+func (p Point) Vector() Vector[int] {
+	return Vector[int]{p.X, p.Y}
+}
+
+// Distance is a method that returns the Manhattan distance between two points.
 func (p Point) Distance(p2 Point) int {
-	return int(math.Abs(float64(p.X-p2.X)) + math.Abs(float64(p.Y-p2.Y)))
+	return Abs(p.X-p2.X) + Abs(p.Y-p2.Y)
 }
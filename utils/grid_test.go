@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -161,6 +162,11 @@ func TestPoint_Distance(t *testing.T) {
 			p2:   Point{5, 11},
 			want: 9,
 		},
+		{
+			p1:   Point{-(1 << 55), 1},
+			p2:   Point{1 << 55, 0},
+			want: 1<<56 + 1,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -477,12 +483,130 @@ func TestInsidePolygonWrongPolygon(t *testing.T) {
 	}
 }
 
+func TestInsidePolygonStartOnRay(t *testing.T) {
+	// The polygon starts in the middle of its bottom side, right of the point (0, 3)
+	polygon := []Point{{2, 3}, {1, 3}, {1, 2}, {1, 1}, {2, 1}, {3, 1}, {3, 2}, {3, 3}, {2, 3}}
+	g := NewGrid(5, 5, 0)
+
+	testCases := []struct {
+		p    Point
+		want bool
+	}{
+		{Point{2, 2}, true},
+		{Point{0, 3}, false},
+		{Point{2, 3}, false},
+		{Point{0, 2}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.p.String(), func(t *testing.T) {
+			got, err := InsidePolygon(tc.p, g, polygon)
+			if err != nil {
+				t.Errorf("got error %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestInsidePolygonPointOutsideGrid(t *testing.T) {
 	if _, err := InsidePolygon(Point{100, 100}, mockGrid, mockPolygon); err == nil {
 		t.Errorf("expected error, got nil")
 	}
 }
 
+// walkPolygon is a function that returns the closed path walked from start, moving one point at a time.
+// Moves are a direction U, D, L or R followed by the number of steps, e.g. "R6".
+func walkPolygon(start Point, moves ...string) []Point {
+	steps := map[byte]Point{'U': {0, -1}, 'D': {0, 1}, 'L': {-1, 0}, 'R': {1, 0}}
+	polygon := []Point{start}
+	current := start
+	for _, move := range moves {
+		var n int
+		fmt.Sscanf(move[1:], "%d", &n)
+		for i := 0; i < n; i++ {
+			current = current.Add(steps[move[0]])
+			polygon = append(polygon, current)
+		}
+	}
+	return polygon
+}
+
+func TestPolygonIndex(t *testing.T) {
+	// A U shape with a notch going up from the bottom edge
+	uShape := walkPolygon(Point{0, 0}, "R6", "D5", "L2", "U3", "L2", "D3", "L2", "U5")
+
+	testCases := []struct {
+		name    string
+		polygon []Point
+		inside  []Point
+		outside []Point
+		count   int
+	}{
+		{
+			name:    "rectangle",
+			polygon: rectanglePolygon(1, 1, 5, 4),
+			inside:  []Point{{2, 2}, {4, 3}},
+			outside: []Point{{0, 0}, {1, 1}, {5, 2}, {6, 2}, {2, 4}},
+			count:   6,
+		},
+		{
+			name:    "u shape",
+			polygon: uShape,
+			inside:  []Point{{1, 1}, {3, 1}, {1, 4}, {5, 3}},
+			outside: []Point{{3, 3}, {3, 4}, {3, 5}, {2, 2}, {7, 1}, {-1, 1}},
+			count:   11,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			index, err := NewPolygonIndex(tc.polygon)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, p := range tc.inside {
+				if !index.Contains(p) {
+					t.Errorf("Contains(%v) = false, want true", p)
+				}
+			}
+			for _, p := range tc.outside {
+				if index.Contains(p) {
+					t.Errorf("Contains(%v) = true, want false", p)
+				}
+			}
+
+			if got := index.CountInside(); got != tc.count {
+				t.Errorf("CountInside() = %d, want %d", got, tc.count)
+			}
+
+			// Counting must agree with checking every point
+			var contained int
+			for y := -1; y < 8; y++ {
+				for x := -1; x < 8; x++ {
+					if index.Contains(Point{x, y}) {
+						contained++
+					}
+				}
+			}
+			if contained != tc.count {
+				t.Errorf("%d points are contained, want %d", contained, tc.count)
+			}
+		})
+	}
+}
+
+func TestNewPolygonIndexOpenPolygon(t *testing.T) {
+	for _, polygon := range [][]Point{nil, {{0, 0}, {1, 0}}} {
+		if _, err := NewPolygonIndex(polygon); err == nil {
+			t.Errorf("NewPolygonIndex(%v) should return an error", polygon)
+		}
+	}
+}
+
 func TestNewGrid(t *testing.T) {
 	g := NewGrid[int](3, 2, 0)
 
@@ -500,3 +624,138 @@ func TestNewGrid(t *testing.T) {
 		}
 	}
 }
+
+func TestParseGrid(t *testing.T) {
+	g, err := ParseGrid([]string{"123", "456", ""}, Digits)
+	if err != nil {
+		t.Fatalf("ParseGrid() unexpected error: %v", err)
+	}
+	if want := (Grid[int]{{1, 2, 3}, {4, 5, 6}}); !reflect.DeepEqual(g, want) {
+		t.Errorf("ParseGrid() = %v, want %v", g, want)
+	}
+
+	bools, err := ParseGrid([]string{"#.", ".#"}, Bools)
+	if err != nil {
+		t.Fatalf("ParseGrid() unexpected error: %v", err)
+	}
+	if want := (Grid[bool]{{true, false}, {false, true}}); !reflect.DeepEqual(bools, want) {
+		t.Errorf("ParseGrid() = %v, want %v", bools, want)
+	}
+
+	positions, err := ParseGrid([]string{"ab", "cd"}, func(_ rune, p Point) (Point, error) { return p, nil })
+	if err != nil {
+		t.Fatalf("ParseGrid() unexpected error: %v", err)
+	}
+	if got, want := positions[1][0], (Point{0, 1}); got != want {
+		t.Errorf("ParseGrid() gave position %v to (0, 1), want %v", got, want)
+	}
+
+	empty, err := ParseGrid(nil, Runes)
+	if err != nil || empty.Height() != 0 {
+		t.Errorf("ParseGrid(nil) = %v, %v, want an empty grid", empty, err)
+	}
+}
+
+func TestParseGridErrors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		lines []string
+		want  string
+	}{
+		{"not rectangular", []string{"12", "345"}, "row 1 has 3 columns, expected 2"},
+		{"not a digit", []string{"12", "3x"}, "(1, 1): 'x' is not a digit"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseGrid(tc.lines, Digits)
+			if err == nil || err.Error() != tc.want {
+				t.Errorf("ParseGrid() error = %v, want %q", err, tc.want)
+			}
+		})
+	}
+
+	if _, err := ParseGrid([]string{"#?"}, Bools); err == nil {
+		t.Errorf("ParseGrid() expected error for a character that is not '#' or '.'")
+	}
+}
+
+func TestParseGridMarkers(t *testing.T) {
+	g, markers, err := ParseGridMarkers([]string{"S.#", ".#E", "S.."}, Runes, "SE")
+	if err != nil {
+		t.Fatalf("ParseGridMarkers() unexpected error: %v", err)
+	}
+
+	if g.Get(Point{0, 0}) != 'S' {
+		t.Errorf("markers should be kept in the grid")
+	}
+
+	want := map[rune][]Point{'S': {{0, 0}, {0, 2}}, 'E': {{2, 1}}}
+	if !reflect.DeepEqual(markers, want) {
+		t.Errorf("ParseGridMarkers() markers = %v, want %v", markers, want)
+	}
+
+	s, err := ParseGrid([]string{"ab"}, Strings)
+	if err != nil || !reflect.DeepEqual(s, Grid[string]{{"a", "b"}}) {
+		t.Errorf("ParseGrid() = %v, %v, want [[a b]]", s, err)
+	}
+}
+
+// rectanglePolygon returns the closed polygon that goes around the border of a rectangle.
+func rectanglePolygon(minX, minY, maxX, maxY int) []Point {
+	var polygon []Point
+	for x := minX; x < maxX; x++ {
+		polygon = append(polygon, Point{x, minY})
+	}
+	for y := minY; y < maxY; y++ {
+		polygon = append(polygon, Point{maxX, y})
+	}
+	for x := maxX; x > minX; x-- {
+		polygon = append(polygon, Point{x, maxY})
+	}
+	for y := maxY; y > minY; y-- {
+		polygon = append(polygon, Point{minX, y})
+	}
+	return append(polygon, Point{minX, minY})
+}
+
+func BenchmarkGrid_Iterator(b *testing.B) {
+	g := NewGrid(200, 200, 1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var sum int
+		for e := range g.Iterator() {
+			sum += e.Value
+		}
+	}
+}
+
+func BenchmarkInsidePolygon(b *testing.B) {
+	g := NewGrid(50, 50, 0)
+	polygon := rectanglePolygon(5, 5, 44, 44)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for y := 0; y < g.Height(); y++ {
+			for x := 0; x < g.Width(); x++ {
+				if _, err := InsidePolygon(Point{x, y}, g, polygon); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkPolygonIndex_CountInside(b *testing.B) {
+	polygon := rectanglePolygon(5, 5, 44, 44)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index, err := NewPolygonIndex(polygon)
+		if err != nil {
+			b.Fatal(err)
+		}
+		index.CountInside()
+	}
+}
@@ -0,0 +1,79 @@
+package interval
+
+import "strings"
+
+// Box is a hyper-rectangle with an Interval along each axis, the points whose coordinates are all in their Interval.
+// A Box is empty when any of its Intervals is, and operations on two Boxes need them to have the same number of axes.
+type Box []Interval
+
+// NewBox is a function that returns a Box with the same Interval along each of the given number of axes.
+func NewBox(axes int, i Interval) Box {
+	b := make(Box, axes)
+	for axis := range b {
+		b[axis] = i
+	}
+	return b
+}
+
+// String is a method that returns a string representation of a Box.
+func (b Box) String() string {
+	parts := make([]string, len(b))
+	for i, interval := range b {
+		parts[i] = interval.String()
+	}
+	return strings.Join(parts, "×")
+}
+
+// Volume is a method that returns the number of points in a Box.
+func (b Box) Volume() int {
+	if len(b) == 0 {
+		return 0
+	}
+
+	volume := 1
+	for _, i := range b {
+		volume *= i.Len()
+	}
+	return volume
+}
+
+// Empty is a method that checks if a Box has no points.
+func (b Box) Empty() bool {
+	return b.Volume() == 0
+}
+
+// Contains is a method that checks if a point, given by one coordinate per axis, is in a Box.
+func (b Box) Contains(point ...int) bool {
+	if len(point) != len(b) {
+		return false
+	}
+	for axis, i := range b {
+		if !i.Contains(point[axis]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersect is a method that returns the largest Box contained by both Boxes.
+func (b Box) Intersect(other Box) Box {
+	result := make(Box, len(b))
+	for axis := range b {
+		result[axis] = b[axis].Intersect(other[axis])
+	}
+	return result
+}
+
+// With is a method that returns a copy of a Box with the Interval along an axis replaced.
+func (b Box) With(axis int, i Interval) Box {
+	result := make(Box, len(b))
+	copy(result, b)
+	result[axis] = i
+	return result
+}
+
+// SplitAt is a method that splits a Box along an axis, into the points whose coordinate is below n and those from n onwards.
+func (b Box) SplitAt(axis, n int) (below, above Box) {
+	belowInterval, aboveInterval := b[axis].SplitAt(n)
+	return b.With(axis, belowInterval), b.With(axis, aboveInterval)
+}
@@ -0,0 +1,48 @@
+package interval
+
+import "testing"
+
+func TestBox(t *testing.T) {
+	// The x, m, a, s ratings of day 19 each go from 1 to 4000
+	parts := NewBox(4, Closed(1, 4000))
+
+	if got := parts.Volume(); got != 4000*4000*4000*4000 {
+		t.Errorf("Volume() = %d, want %d", got, 4000*4000*4000*4000)
+	}
+
+	// a<2006 keeps ratings of a below 2006
+	satisfied, unsatisfied := parts.SplitAt(2, 2006)
+	if got, want := satisfied[2], Closed(1, 2005); got != want {
+		t.Errorf("satisfied along a = %v, want %v", got, want)
+	}
+	if got, want := unsatisfied[2], Closed(2006, 4000); got != want {
+		t.Errorf("unsatisfied along a = %v, want %v", got, want)
+	}
+	if satisfied.Volume()+unsatisfied.Volume() != parts.Volume() {
+		t.Errorf("splitting lost points")
+	}
+	if parts[2] != Closed(1, 4000) {
+		t.Errorf("SplitAt changed the box to %v", parts)
+	}
+
+	if !satisfied.Contains(1, 4000, 2005, 7) || satisfied.Contains(1, 4000, 2006, 7) {
+		t.Errorf("Contains does not follow the split along a")
+	}
+	if satisfied.Contains(1, 2, 3) {
+		t.Errorf("Contains should be false for a point with the wrong number of axes")
+	}
+
+	if got := satisfied.Intersect(unsatisfied); !got.Empty() {
+		t.Errorf("Intersect() = %v, want an empty box", got)
+	}
+	if got := parts.Intersect(satisfied); got.Volume() != satisfied.Volume() {
+		t.Errorf("Intersect() = %v, want %v", got, satisfied)
+	}
+
+	if got, want := (Box{New(0, 2), New(3, 5)}).String(), "[0, 2)×[3, 5)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if !(Box{}).Empty() {
+		t.Errorf("a box without axes should be empty")
+	}
+}
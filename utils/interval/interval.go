@@ -0,0 +1,78 @@
+// Package interval does arithmetic on ranges of integers, on sets of them and on boxes made of one range per axis.
+package interval
+
+import "fmt"
+
+// Interval is a struct that represents the integers n with Start <= n < End.
+// An Interval with End <= Start is empty.
+type Interval struct {
+	Start, End int
+}
+
+// New is a function that returns the Interval from start up to, but not including, end.
+func New(start, end int) Interval {
+	return Interval{Start: start, End: end}
+}
+
+// Closed is a function that returns the Interval from first to last, both included.
+// Puzzles usually give ranges this way, such as "2-4".
+func Closed(first, last int) Interval {
+	return Interval{Start: first, End: last + 1}
+}
+
+// String is a method that returns a string representation of an Interval.
+func (i Interval) String() string {
+	return fmt.Sprintf("[%d, %d)", i.Start, i.End)
+}
+
+// Len is a method that returns the number of integers in an Interval.
+func (i Interval) Len() int {
+	return max(i.End-i.Start, 0)
+}
+
+// Empty is a method that checks if an Interval has no integers.
+func (i Interval) Empty() bool {
+	return i.End <= i.Start
+}
+
+// Last is a method that returns the largest integer in an Interval, which must not be empty.
+func (i Interval) Last() int {
+	return i.End - 1
+}
+
+// Contains is a method that checks if an integer is in an Interval.
+func (i Interval) Contains(n int) bool {
+	return n >= i.Start && n < i.End
+}
+
+// ContainsInterval is a method that checks if every integer of another Interval is in an Interval.
+// An empty Interval is contained by any Interval.
+func (i Interval) ContainsInterval(other Interval) bool {
+	return other.Empty() || (other.Start >= i.Start && other.End <= i.End)
+}
+
+// Overlaps is a method that checks if two Intervals have any integer in common.
+func (i Interval) Overlaps(other Interval) bool {
+	return !i.Intersect(other).Empty()
+}
+
+// Intersect is a method that returns the largest Interval contained by both Intervals.
+// Intervals that do not overlap intersect in an empty Interval.
+func (i Interval) Intersect(other Interval) Interval {
+	i.Start = max(i.Start, other.Start)
+	i.End = max(min(i.End, other.End), i.Start)
+	return i
+}
+
+// Shift is a method that returns an Interval moved by offset.
+func (i Interval) Shift(offset int) Interval {
+	return Interval{i.Start + offset, i.End + offset}
+}
+
+// SplitAt is a method that splits an Interval into the integers below n and the integers from n onwards.
+// Either part is empty when n is outside the Interval.
+func (i Interval) SplitAt(n int) (below, above Interval) {
+	below = Interval{i.Start, max(min(i.End, n), i.Start)}
+	above = Interval{min(max(i.Start, n), i.End), i.End}
+	return below, above
+}
@@ -0,0 +1,123 @@
+package interval
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestInterval(t *testing.T) {
+	testCases := []struct {
+		interval Interval
+		len      int
+		empty    bool
+		contains []int
+		outside  []int
+	}{
+		{New(2, 5), 3, false, []int{2, 3, 4}, []int{1, 5}},
+		{Closed(2, 4), 3, false, []int{2, 3, 4}, []int{1, 5}},
+		{New(3, 3), 0, true, nil, []int{2, 3, 4}},
+		{New(5, 1), 0, true, nil, []int{1, 3, 5}},
+		{New(-3, 0), 3, false, []int{-3, -1}, []int{0, -4}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.interval.String(), func(t *testing.T) {
+			if got := tc.interval.Len(); got != tc.len {
+				t.Errorf("Len() = %d, want %d", got, tc.len)
+			}
+			if got := tc.interval.Empty(); got != tc.empty {
+				t.Errorf("Empty() = %v, want %v", got, tc.empty)
+			}
+			for _, n := range tc.contains {
+				if !tc.interval.Contains(n) {
+					t.Errorf("Contains(%d) = false, want true", n)
+				}
+			}
+			for _, n := range tc.outside {
+				if tc.interval.Contains(n) {
+					t.Errorf("Contains(%d) = true, want false", n)
+				}
+			}
+		})
+	}
+}
+
+func TestInterval_Intersect(t *testing.T) {
+	testCases := []struct {
+		a, b     Interval
+		want     Interval
+		overlaps bool
+	}{
+		{New(0, 10), New(5, 15), New(5, 10), true},
+		{New(5, 15), New(0, 10), New(5, 10), true},
+		{New(0, 10), New(2, 3), New(2, 3), true},
+		{New(0, 5), New(5, 10), New(5, 5), false},
+		{New(0, 5), New(7, 10), New(7, 7), false},
+		{Closed(2, 4), Closed(4, 6), Closed(4, 4), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%v∩%v", tc.a, tc.b), func(t *testing.T) {
+			if got := tc.a.Intersect(tc.b); got != tc.want {
+				t.Errorf("Intersect() = %v, want %v", got, tc.want)
+			}
+			if got := tc.a.Overlaps(tc.b); got != tc.overlaps {
+				t.Errorf("Overlaps() = %v, want %v", got, tc.overlaps)
+			}
+		})
+	}
+}
+
+func TestInterval_ContainsInterval(t *testing.T) {
+	testCases := []struct {
+		a, b Interval
+		want bool
+	}{
+		{Closed(2, 8), Closed(3, 7), true},
+		{Closed(3, 7), Closed(2, 8), false},
+		{Closed(6, 6), Closed(6, 6), true},
+		{Closed(2, 6), Closed(4, 8), false},
+		{New(2, 6), New(10, 10), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%v⊇%v", tc.a, tc.b), func(t *testing.T) {
+			if got := tc.a.ContainsInterval(tc.b); got != tc.want {
+				t.Errorf("ContainsInterval() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestInterval_SplitAt(t *testing.T) {
+	i := New(5, 11)
+
+	testCases := []struct {
+		n            int
+		below, above Interval
+	}{
+		{8, New(5, 8), New(8, 11)},
+		{5, New(5, 5), New(5, 11)},
+		{11, New(5, 11), New(11, 11)},
+		{0, New(5, 5), New(5, 11)},
+		{20, New(5, 11), New(11, 11)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.n), func(t *testing.T) {
+			below, above := i.SplitAt(tc.n)
+			if below != tc.below || above != tc.above {
+				t.Errorf("SplitAt(%d) = %v, %v, want %v, %v", tc.n, below, above, tc.below, tc.above)
+			}
+			if below.Len()+above.Len() != i.Len() {
+				t.Errorf("SplitAt(%d) lost integers", tc.n)
+			}
+		})
+	}
+}
+
+func TestInterval_Shift(t *testing.T) {
+	if got, want := Closed(98, 99).Shift(-48), Closed(50, 51); got != want {
+		t.Errorf("Shift() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,156 @@
+package interval
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// IntervalSet is a struct that represents a set of integers as the Intervals that make it up.
+// The Intervals are kept sorted, non-empty, and apart from each other, so each set has a single representation.
+// Operations return a new IntervalSet and leave the ones they are given untouched.
+type IntervalSet struct {
+	intervals []Interval
+}
+
+// NewIntervalSet is a function that returns the set of the integers in any of the Intervals.
+// The Intervals may overlap and be in any order.
+func NewIntervalSet(intervals ...Interval) IntervalSet {
+	return IntervalSet{normalize(slices.Clone(intervals))}
+}
+
+// normalize is a function that sorts Intervals and merges those that overlap or touch, dropping empty ones.
+// The slice is reused for the result.
+func normalize(intervals []Interval) []Interval {
+	intervals = slices.DeleteFunc(intervals, Interval.Empty)
+	slices.SortFunc(intervals, func(a, b Interval) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	merged := intervals[:0]
+	for _, i := range intervals {
+		if last := len(merged) - 1; last >= 0 && i.Start <= merged[last].End {
+			merged[last].End = max(merged[last].End, i.End)
+			continue
+		}
+		merged = append(merged, i)
+	}
+	return merged
+}
+
+// String is a method that returns a string representation of an IntervalSet.
+func (s IntervalSet) String() string {
+	parts := make([]string, len(s.intervals))
+	for i, interval := range s.intervals {
+		parts[i] = interval.String()
+	}
+	return "{" + strings.Join(parts, " ") + "}"
+}
+
+// Intervals is a method that returns a copy of the Intervals of an IntervalSet, sorted.
+func (s IntervalSet) Intervals() []Interval {
+	return slices.Clone(s.intervals)
+}
+
+// Len is a method that returns the number of integers in an IntervalSet.
+func (s IntervalSet) Len() int {
+	var length int
+	for _, i := range s.intervals {
+		length += i.Len()
+	}
+	return length
+}
+
+// Empty is a method that checks if an IntervalSet has no integers.
+func (s IntervalSet) Empty() bool {
+	return len(s.intervals) == 0
+}
+
+// Min is a method that returns the smallest integer of an IntervalSet, and false if it is empty.
+func (s IntervalSet) Min() (int, bool) {
+	if s.Empty() {
+		return 0, false
+	}
+	return s.intervals[0].Start, true
+}
+
+// Contains is a method that checks if an integer is in an IntervalSet, in logarithmic time.
+func (s IntervalSet) Contains(n int) bool {
+	idx, _ := slices.BinarySearchFunc(s.intervals, n, func(i Interval, n int) int {
+		return cmp.Compare(i.Last(), n)
+	})
+	return idx < len(s.intervals) && s.intervals[idx].Contains(n)
+}
+
+// Union is a method that returns the integers in either IntervalSet.
+func (s IntervalSet) Union(other IntervalSet) IntervalSet {
+	return IntervalSet{normalize(slices.Concat(s.intervals, other.intervals))}
+}
+
+// Intersect is a method that returns the integers in both IntervalSets.
+func (s IntervalSet) Intersect(other IntervalSet) IntervalSet {
+	var result []Interval
+
+	// Both sets are sorted, so walk them together and move past whichever Interval ends first
+	for i, j := 0, 0; i < len(s.intervals) && j < len(other.intervals); {
+		a, b := s.intervals[i], other.intervals[j]
+		if both := a.Intersect(b); !both.Empty() {
+			result = append(result, both)
+		}
+		if a.End < b.End {
+			i++
+		} else {
+			j++
+		}
+	}
+	return IntervalSet{result}
+}
+
+// Difference is a method that returns the integers of an IntervalSet that are not in the other one.
+func (s IntervalSet) Difference(other IntervalSet) IntervalSet {
+	var result []Interval
+
+	j := 0
+	for _, a := range s.intervals {
+		// Skip the Intervals that end before this one starts, as they do not end after the next ones start either
+		for j < len(other.intervals) && other.intervals[j].End <= a.Start {
+			j++
+		}
+
+		// Cut out every Interval that overlaps this one, keeping what is left of it
+		for k := j; k < len(other.intervals) && other.intervals[k].Start < a.End; k++ {
+			below, rest := a.SplitAt(other.intervals[k].Start)
+			if !below.Empty() {
+				result = append(result, below)
+			}
+			_, a = rest.SplitAt(other.intervals[k].End)
+		}
+		if !a.Empty() {
+			result = append(result, a)
+		}
+	}
+	return IntervalSet{result}
+}
+
+// SplitAt is a method that splits an IntervalSet into the integers below n and the integers from n onwards.
+func (s IntervalSet) SplitAt(n int) (below, above IntervalSet) {
+	for _, i := range s.intervals {
+		b, a := i.SplitAt(n)
+		if !b.Empty() {
+			below.intervals = append(below.intervals, b)
+		}
+		if !a.Empty() {
+			above.intervals = append(above.intervals, a)
+		}
+	}
+	return below, above
+}
+
+// Shift is a method that returns an IntervalSet with every integer moved by offset.
+func (s IntervalSet) Shift(offset int) IntervalSet {
+	shifted := make([]Interval, len(s.intervals))
+	for i, interval := range s.intervals {
+		shifted[i] = interval.Shift(offset)
+	}
+	return IntervalSet{shifted}
+}
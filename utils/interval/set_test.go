@@ -0,0 +1,151 @@
+package interval
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestNewIntervalSet(t *testing.T) {
+	testCases := []struct {
+		name      string
+		intervals []Interval
+		want      []Interval
+	}{
+		{"empty", nil, nil},
+		{"only empty intervals", []Interval{New(3, 3), New(5, 2)}, nil},
+		{"sorted", []Interval{New(5, 7), New(0, 2)}, []Interval{New(0, 2), New(5, 7)}},
+		{"overlapping", []Interval{New(0, 5), New(3, 8)}, []Interval{New(0, 8)}},
+		{"touching", []Interval{New(0, 5), New(5, 8)}, []Interval{New(0, 8)}},
+		{"nested", []Interval{New(0, 10), New(2, 3), New(12, 13)}, []Interval{New(0, 10), New(12, 13)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewIntervalSet(tc.intervals...)
+			if got := s.Intervals(); !slices.Equal(got, tc.want) {
+				t.Errorf("Intervals() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIntervalSet_Operations(t *testing.T) {
+	a := NewIntervalSet(New(0, 10), New(20, 30))
+	b := NewIntervalSet(New(5, 25), New(28, 40))
+
+	testCases := []struct {
+		name string
+		got  IntervalSet
+		want []Interval
+	}{
+		{"union", a.Union(b), []Interval{New(0, 40)}},
+		{"intersect", a.Intersect(b), []Interval{New(5, 10), New(20, 25), New(28, 30)}},
+		{"difference", a.Difference(b), []Interval{New(0, 5), New(25, 28)}},
+		{"reverse difference", b.Difference(a), []Interval{New(10, 20), New(30, 40)}},
+		{"shift", a.Shift(-5), []Interval{New(-5, 5), New(15, 25)}},
+		{"difference with itself", a.Difference(a), nil},
+		{"difference with empty", a.Difference(IntervalSet{}), []Interval{New(0, 10), New(20, 30)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.got.Intervals(); !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	// Operations leave their sets untouched
+	if got, want := a.Intervals(), []Interval{New(0, 10), New(20, 30)}; !slices.Equal(got, want) {
+		t.Errorf("operations changed the set to %v", got)
+	}
+}
+
+func TestIntervalSet_SplitAt(t *testing.T) {
+	s := NewIntervalSet(New(0, 10), New(20, 30))
+
+	below, above := s.SplitAt(25)
+	if got, want := below.Intervals(), []Interval{New(0, 10), New(20, 25)}; !slices.Equal(got, want) {
+		t.Errorf("below = %v, want %v", got, want)
+	}
+	if got, want := above.Intervals(), []Interval{New(25, 30)}; !slices.Equal(got, want) {
+		t.Errorf("above = %v, want %v", got, want)
+	}
+
+	below, above = s.SplitAt(15)
+	if below.Len() != 10 || above.Len() != 10 {
+		t.Errorf("SplitAt(15) = %v, %v, want 10 integers each", below, above)
+	}
+}
+
+func TestIntervalSet_Measures(t *testing.T) {
+	s := NewIntervalSet(New(20, 30), Closed(3, 7))
+
+	if got := s.Len(); got != 15 {
+		t.Errorf("Len() = %d, want 15", got)
+	}
+	if got, ok := s.Min(); !ok || got != 3 {
+		t.Errorf("Min() = %d, %v, want 3, true", got, ok)
+	}
+	if _, ok := (IntervalSet{}).Min(); ok {
+		t.Errorf("Min() of an empty set should not be ok")
+	}
+	if got, want := s.String(), "{[3, 8) [20, 30)}"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+// members is a function that returns the set of integers of an IntervalSet, checking them one by one.
+func members(s IntervalSet, from, to int) map[int]bool {
+	m := make(map[int]bool)
+	for n := from; n < to; n++ {
+		if s.Contains(n) {
+			m[n] = true
+		}
+	}
+	return m
+}
+
+func TestIntervalSet_MatchesIntegers(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	randomSet := func() IntervalSet {
+		intervals := make([]Interval, r.IntN(5))
+		for i := range intervals {
+			start := r.IntN(50)
+			intervals[i] = New(start, start+r.IntN(10))
+		}
+		return NewIntervalSet(intervals...)
+	}
+
+	for range 500 {
+		a, b := randomSet(), randomSet()
+		ma, mb := members(a, -5, 70), members(b, -5, 70)
+
+		if a.Len() != len(ma) {
+			t.Fatalf("%v.Len() = %d, want %d", a, a.Len(), len(ma))
+		}
+
+		for n := -5; n < 70; n++ {
+			if got, want := a.Union(b).Contains(n), ma[n] || mb[n]; got != want {
+				t.Fatalf("%v ∪ %v contains %d: %v, want %v", a, b, n, got, want)
+			}
+			if got, want := a.Intersect(b).Contains(n), ma[n] && mb[n]; got != want {
+				t.Fatalf("%v ∩ %v contains %d: %v, want %v", a, b, n, got, want)
+			}
+			if got, want := a.Difference(b).Contains(n), ma[n] && !mb[n]; got != want {
+				t.Fatalf("%v - %v contains %d: %v, want %v", a, b, n, got, want)
+			}
+		}
+
+		// Results are normalized, so equal sets have the same Intervals
+		union := a.Union(b)
+		if got := NewIntervalSet(union.Intervals()...); !slices.Equal(got.Intervals(), union.Intervals()) {
+			t.Fatalf("union %v is not normalized", union)
+		}
+		intersect, difference := a.Intersect(b), a.Difference(b)
+		if got := intersect.Union(difference); !slices.Equal(got.Intervals(), a.Intervals()) {
+			t.Fatalf("(%v ∩ %v) ∪ (%v - %v) = %v, want %v", a, b, a, b, got, a)
+		}
+	}
+}
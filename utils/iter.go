@@ -0,0 +1,74 @@
+package utils
+
+import "iter"
+
+// All is a method that returns an iterator over every point of a Grid and its value, in row-major order.
+func (g Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for y, row := range g {
+			for x, value := range row {
+				if !yield(Point{x, y}, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// AllRow is a method that returns an iterator over the points of row y of a Grid and their values, from left to right.
+func (g Grid[T]) AllRow(y int) iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for x, value := range g[y] {
+			if !yield(Point{x, y}, value) {
+				return
+			}
+		}
+	}
+}
+
+// AllCol is a method that returns an iterator over the points of column x of a Grid and their values, from top to bottom.
+func (g Grid[T]) AllCol(x int) iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for y, row := range g {
+			if !yield(Point{x, y}, row[x]) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbours is a method that returns an iterator over the points next to p that are in the Grid, and their values.
+// Neighbours are the points that share a side with p, clockwise from the one above it.
+func (g Grid[T]) Neighbours(p Point) iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for _, n := range p.Neighbours4(g) {
+			if !yield(n, g.Get(n)) {
+				return
+			}
+		}
+	}
+}
+
+// Each is a method that calls fn with every point of a Grid and its value, in row-major order.
+func (g Grid[T]) Each(fn func(Point, T)) {
+	for y, row := range g {
+		for x, value := range row {
+			fn(Point{x, y}, value)
+		}
+	}
+}
+
+// elements is a function that sends every point and value of an iterator through a channel as an Element.
+// The goroutine only ends when every element was received, so the channel must be drained.
+func elements[T any](seq iter.Seq2[Point, T]) <-chan Element[T] {
+	ch := make(chan Element[T])
+
+	go func() {
+		defer close(ch)
+		for p, value := range seq {
+			ch <- Element[T]{value, p}
+		}
+	}()
+
+	return ch
+}
@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"iter"
+	"reflect"
+	"testing"
+)
+
+// pointValue is a point of a grid and its value, collected from an iterator.
+type pointValue struct {
+	P     Point
+	Value string
+}
+
+func TestGrid_AllIterators(t *testing.T) {
+	g := Grid[string]{
+		{"a", "b", "c"},
+		{"d", "e", "f"},
+		{"g", "h", "i"},
+	}
+
+	testCases := []struct {
+		name string
+		seq  iter.Seq2[Point, string]
+		want []pointValue
+	}{
+		{"all", g.All(), []pointValue{
+			{Point{0, 0}, "a"}, {Point{1, 0}, "b"}, {Point{2, 0}, "c"},
+			{Point{0, 1}, "d"}, {Point{1, 1}, "e"}, {Point{2, 1}, "f"},
+			{Point{0, 2}, "g"}, {Point{1, 2}, "h"}, {Point{2, 2}, "i"},
+		}},
+		{"row", g.AllRow(1), []pointValue{{Point{0, 1}, "d"}, {Point{1, 1}, "e"}, {Point{2, 1}, "f"}}},
+		{"col", g.AllCol(2), []pointValue{{Point{2, 0}, "c"}, {Point{2, 1}, "f"}, {Point{2, 2}, "i"}}},
+		{"neighbours", g.Neighbours(Point{1, 1}), []pointValue{
+			{Point{1, 0}, "b"}, {Point{2, 1}, "f"}, {Point{1, 2}, "h"}, {Point{0, 1}, "d"},
+		}},
+		{"neighbours of a corner", g.Neighbours(Point{0, 0}), []pointValue{{Point{1, 0}, "b"}, {Point{0, 1}, "d"}}},
+		{"neighbours outside", g.Neighbours(Point{-1, 1}), []pointValue{{Point{0, 1}, "d"}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []pointValue
+			for p, value := range tc.seq {
+				got = append(got, pointValue{p, value})
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGrid_AllBreak(t *testing.T) {
+	g := NewGrid(10, 10, 1)
+
+	var count int
+	for p := range g.All() {
+		count++
+		if p == (Point{2, 1}) {
+			break
+		}
+	}
+
+	if count != 13 {
+		t.Errorf("got %d points before break, want 13", count)
+	}
+}
+
+func TestGrid_Each(t *testing.T) {
+	g := Grid[int]{{1, 2}, {3, 4}}
+
+	var points []Point
+	var sum int
+	g.Each(func(p Point, value int) {
+		points = append(points, p)
+		sum += value
+	})
+
+	want := []Point{{0, 0}, {1, 0}, {0, 1}, {1, 1}}
+	if !reflect.DeepEqual(points, want) || sum != 10 {
+		t.Errorf("got %v with sum %d, want %v with sum 10", points, sum, want)
+	}
+}
+
+func BenchmarkGrid_All(b *testing.B) {
+	g := NewGrid(200, 200, 1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var sum int
+		for _, value := range g.All() {
+			sum += value
+		}
+	}
+}
+
+func BenchmarkGrid_Each(b *testing.B) {
+	g := NewGrid(200, 200, 1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var sum int
+		g.Each(func(_ Point, value int) {
+			sum += value
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// VerbosityEnv is the environment variable the runner uses to pass the verbosity to the solvers.
+const VerbosityEnv = "AOC_VERBOSITY"
+
+// LevelForVerbosity is a function that returns the lowest level logged at a verbosity.
+// 0 logs warnings and errors, 1 (-v) adds info and 2 or more (-vv) adds debug.
+func LevelForVerbosity(verbosity int) slog.Level {
+	switch {
+	case verbosity <= 0:
+		return slog.LevelWarn
+	case verbosity == 1:
+		return slog.LevelInfo
+	default:
+		return slog.LevelDebug
+	}
+}
+
+// NewLogger is a function that returns a logger that writes text records to w at the given verbosity.
+func NewLogger(w io.Writer, verbosity int) *slog.Logger {
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{Level: LevelForVerbosity(verbosity)}))
+}
+
+// VerbosityFromEnv is a function that returns the verbosity set by the runner, or 0 if it is not set or invalid.
+func VerbosityFromEnv() int {
+	verbosity, err := strconv.Atoi(os.Getenv(VerbosityEnv))
+	if err != nil {
+		return 0
+	}
+	return verbosity
+}
+
+var defaultLogger = sync.OnceValue(func() *slog.Logger {
+	return NewLogger(os.Stderr, VerbosityFromEnv())
+})
+
+// Logger is a function that returns the logger solvers use when run from the runner.
+// It writes to stderr, so debug output never mixes with the answers printed to stdout.
+func Logger() *slog.Logger {
+	return defaultLogger()
+}
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLevelForVerbosity(t *testing.T) {
+	testCases := []TestCase[int, slog.Level]{
+		{Input: -1, Expected: slog.LevelWarn},
+		{Input: 0, Expected: slog.LevelWarn},
+		{Input: 1, Expected: slog.LevelInfo},
+		{Input: 2, Expected: slog.LevelDebug},
+		{Input: 5, Expected: slog.LevelDebug},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strings.Repeat("v", max(tc.Input, 0)), func(t *testing.T) {
+			if got := LevelForVerbosity(tc.Input); got != tc.Expected {
+				t.Errorf("got %v, want %v", got, tc.Expected)
+			}
+		})
+	}
+}
+
+func TestNewLogger(t *testing.T) {
+	testCases := []struct {
+		verbosity int
+		want      []string
+		notWant   []string
+	}{
+		{0, []string{"level=WARN"}, []string{"level=INFO", "level=DEBUG"}},
+		{1, []string{"level=WARN", "level=INFO"}, []string{"level=DEBUG"}},
+		{2, []string{"level=WARN", "level=INFO", "level=DEBUG"}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strings.Repeat("v", tc.verbosity), func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := NewLogger(&buf, tc.verbosity)
+			logger.Debug("debug")
+			logger.Info("info")
+			logger.Warn("warn")
+
+			for _, s := range tc.want {
+				if !strings.Contains(buf.String(), s) {
+					t.Errorf("output %q should contain %q", buf.String(), s)
+				}
+			}
+			for _, s := range tc.notWant {
+				if strings.Contains(buf.String(), s) {
+					t.Errorf("output %q should not contain %q", buf.String(), s)
+				}
+			}
+		})
+	}
+}
+
+func TestVerbosityFromEnv(t *testing.T) {
+	testCases := []TestCase[string, int]{
+		{Input: "", Expected: 0},
+		{Input: "2", Expected: 2},
+		{Input: "invalid", Expected: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Input, func(t *testing.T) {
+			t.Setenv(VerbosityEnv, tc.Input)
+			if got := VerbosityFromEnv(); got != tc.Expected {
+				t.Errorf("got %d, want %d", got, tc.Expected)
+			}
+		})
+	}
+}
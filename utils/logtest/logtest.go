@@ -0,0 +1,26 @@
+// Package logtest provides loggers for tests, kept apart from utils so solvers do not link the testing package.
+package logtest
+
+import (
+	"bytes"
+	"github.com/iamlucasvieira/aoc/utils"
+	"log/slog"
+	"testing"
+)
+
+// testWriter is an io.Writer that sends each line it receives to a test log.
+type testWriter struct {
+	t testing.TB
+}
+
+func (w testWriter) Write(p []byte) (int, error) {
+	w.t.Helper()
+	w.t.Log(string(bytes.TrimRight(p, "\n")))
+	return len(p), nil
+}
+
+// New is a function that returns a logger writing debug records to the log of a test,
+// so the output is captured per subtest and only shown when it fails or runs with -v.
+func New(t testing.TB) *slog.Logger {
+	return utils.NewLogger(testWriter{t: t}, 2)
+}
@@ -0,0 +1,30 @@
+package logtest
+
+import (
+	"strings"
+	"testing"
+)
+
+// recordingT is a testing.TB that records what is logged to it.
+type recordingT struct {
+	testing.TB
+	logs []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Log(args ...any) {
+	r.logs = append(r.logs, args[0].(string))
+}
+
+func TestNew(t *testing.T) {
+	rt := &recordingT{TB: t}
+	New(rt).Debug("visited", "nodes", 3)
+
+	if len(rt.logs) != 1 {
+		t.Fatalf("got %d logs, want 1", len(rt.logs))
+	}
+	if !strings.Contains(rt.logs[0], "msg=visited nodes=3") || strings.HasSuffix(rt.logs[0], "\n") {
+		t.Errorf("unexpected log %q", rt.logs[0])
+	}
+}
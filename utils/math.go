@@ -1,5 +1,14 @@
 package utils
 
+// Abs returns the absolute value of n, without converting it to a float.
+// Like in most languages, the absolute value of the smallest integer of a type overflows and is that integer.
+func Abs[T Integer](n T) T {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
 // GCD returns the Greatest Common Divisor of a and b
 func GCD(a, b int) int {
 	for b != 0 {
@@ -37,6 +37,35 @@ func TestGCD(t *testing.T) {
 	}
 }
 
+func TestAbs(t *testing.T) {
+	testCases := []struct {
+		n    int
+		want int
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 1},
+		{42, 42},
+		{-42, 42},
+		// Beyond 2^53 a float64 can not hold every integer
+		{-(1<<60 + 1), 1<<60 + 1},
+		{1<<53 + 1, 1<<53 + 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run("", func(t *testing.T) {
+			got := Abs(tc.n)
+			if got != tc.want {
+				t.Errorf("Abs(%v) = %v, want %v", tc.n, got, tc.want)
+			}
+		})
+	}
+
+	if got := Abs(int8(-128)); got != -128 {
+		t.Errorf("Abs(int8(-128)) = %v, want overflow to -128", got)
+	}
+}
+
 func TestLCM(t *testing.T) {
 	testCases := []struct {
 		integers []int
@@ -71,3 +100,11 @@ func TestLCM(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkLCM(b *testing.B) {
+	integers := []int{20093, 12169, 22357, 14999, 17263, 16697}
+
+	for i := 0; i < b.N; i++ {
+		LCM(integers...)
+	}
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// smallGlyphs are the letters of the 6 pixels high Advent of Code alphabet.
+var smallGlyphs = map[rune][]string{
+	'A': {".##.", "#..#", "#..#", "####", "#..#", "#..#"},
+	'B': {"###.", "#..#", "###.", "#..#", "#..#", "###."},
+	'C': {".##.", "#..#", "#...", "#...", "#..#", ".##."},
+	'E': {"####", "#...", "###.", "#...", "#...", "####"},
+	'F': {"####", "#...", "###.", "#...", "#...", "#..."},
+	'G': {".##.", "#..#", "#...", "#.##", "#..#", ".###"},
+	'H': {"#..#", "#..#", "####", "#..#", "#..#", "#..#"},
+	'I': {"###", ".#.", ".#.", ".#.", ".#.", "###"},
+	'J': {"..##", "...#", "...#", "...#", "#..#", ".##."},
+	'K': {"#..#", "#.#.", "##..", "#.#.", "#.#.", "#..#"},
+	'L': {"#...", "#...", "#...", "#...", "#...", "####"},
+	'O': {".##.", "#..#", "#..#", "#..#", "#..#", ".##."},
+	'P': {"###.", "#..#", "#..#", "###.", "#...", "#..."},
+	'R': {"###.", "#..#", "#..#", "###.", "#.#.", "#..#"},
+	'S': {".###", "#...", "#...", ".##.", "...#", "###."},
+	'U': {"#..#", "#..#", "#..#", "#..#", "#..#", ".##."},
+	'Y': {"#...#", "#...#", ".#.#.", "..#..", "..#..", "..#.."},
+	'Z': {"####", "...#", "..#.", ".#..", "#...", "####"},
+}
+
+// largeGlyphs are the letters of the 10 pixels high Advent of Code alphabet.
+var largeGlyphs = map[rune][]string{
+	'A': {"..##..", ".#..#.", "#....#", "#....#", "#....#", "######", "#....#", "#....#", "#....#", "#....#"},
+	'B': {"#####.", "#....#", "#....#", "#....#", "#####.", "#....#", "#....#", "#....#", "#....#", "#####."},
+	'C': {".####.", "#....#", "#.....", "#.....", "#.....", "#.....", "#.....", "#.....", "#....#", ".####."},
+	'E': {"######", "#.....", "#.....", "#.....", "#####.", "#.....", "#.....", "#.....", "#.....", "######"},
+	'F': {"######", "#.....", "#.....", "#.....", "#####.", "#.....", "#.....", "#.....", "#.....", "#....."},
+	'G': {".####.", "#....#", "#.....", "#.....", "#.....", "#..###", "#....#", "#....#", "#...##", ".###.#"},
+	'H': {"#....#", "#....#", "#....#", "#....#", "######", "#....#", "#....#", "#....#", "#....#", "#....#"},
+	'J': {"...###", "....#.", "....#.", "....#.", "....#.", "....#.", "....#.", "#...#.", "#...#.", ".###.."},
+	'K': {"#....#", "#...#.", "#..#..", "#.#...", "##....", "##....", "#.#...", "#..#..", "#...#.", "#....#"},
+	'L': {"#.....", "#.....", "#.....", "#.....", "#.....", "#.....", "#.....", "#.....", "#.....", "######"},
+	'N': {"#....#", "##...#", "##...#", "#.#..#", "#.#..#", "#..#.#", "#..#.#", "#...##", "#...##", "#....#"},
+	'P': {"#####.", "#....#", "#....#", "#....#", "#####.", "#.....", "#.....", "#.....", "#.....", "#....."},
+	'R': {"#####.", "#....#", "#....#", "#....#", "#####.", "#..#..", "#...#.", "#...#.", "#....#", "#....#"},
+	'X': {"#....#", "#....#", ".#..#.", ".#..#.", "..##..", "..##..", ".#..#.", ".#..#.", "#....#", "#....#"},
+	'Z': {"######", ".....#", ".....#", "....#.", "...#..", "..#...", ".#....", "#.....", "#.....", "######"},
+}
+
+// glyphsByHeight maps the height of an alphabet to its glyphs, keyed by their rendering.
+var glyphsByHeight = map[int]map[string]rune{
+	6:  glyphIndex(smallGlyphs),
+	10: glyphIndex(largeGlyphs),
+}
+
+// glyphIndex returns a map from the rendering of each glyph to its letter.
+func glyphIndex(glyphs map[rune][]string) map[string]rune {
+	index := make(map[string]rune, len(glyphs))
+	for letter, rows := range glyphs {
+		index[strings.Join(rows, "\n")] = letter
+	}
+	return index
+}
+
+// OCR is a function that reads the letters drawn in a grid, where true is a lit pixel.
+// It recognizes the 6 and 10 pixels high alphabets used by Advent of Code. Letters are separated by
+// columns without lit pixels, and empty rows and columns around the letters are ignored.
+func OCR(grid Grid[bool]) (string, error) {
+	litRow := func(y int) bool {
+		for _, lit := range grid[y] {
+			if lit {
+				return true
+			}
+		}
+		return false
+	}
+
+	litColumn := func(x, top, bottom int) bool {
+		for y := top; y <= bottom; y++ {
+			if x < len(grid[y]) && grid[y][x] {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Find the rows that contain the letters
+	top, bottom := 0, grid.Height()-1
+	for top <= bottom && !litRow(top) {
+		top++
+	}
+	for bottom >= top && !litRow(bottom) {
+		bottom--
+	}
+
+	if top > bottom {
+		return "", fmt.Errorf("no lit pixels in grid")
+	}
+
+	height := bottom - top + 1
+	glyphs, ok := glyphsByHeight[height]
+	if !ok {
+		return "", fmt.Errorf("letters are %d pixels high, expected 6 or 10", height)
+	}
+
+	width := 0
+	for y := top; y <= bottom; y++ {
+		width = max(width, len(grid[y]))
+	}
+
+	var text strings.Builder
+	for x := 0; x < width; x++ {
+		if !litColumn(x, top, bottom) {
+			continue
+		}
+
+		// A letter spans all consecutive columns with lit pixels
+		start := x
+		for x < width && litColumn(x, top, bottom) {
+			x++
+		}
+
+		rows := make([]string, height)
+		for y := range rows {
+			var row strings.Builder
+			for i := start; i < x; i++ {
+				if i < len(grid[top+y]) && grid[top+y][i] {
+					row.WriteByte('#')
+				} else {
+					row.WriteByte('.')
+				}
+			}
+			rows[y] = row.String()
+		}
+
+		glyph := strings.Join(rows, "\n")
+		letter, ok := glyphs[glyph]
+		if !ok {
+			return "", fmt.Errorf("unknown glyph at column %d after %q:\n%s", start, text.String(), glyph)
+		}
+		text.WriteRune(letter)
+	}
+
+	return text.String(), nil
+}
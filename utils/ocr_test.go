@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+// drawLetters is a helper that draws text with the given alphabet, one blank column between letters.
+func drawLetters(text string, glyphs map[rune][]string, height int) Grid[bool] {
+	grid := make(Grid[bool], height)
+	for i, letter := range text {
+		for y, row := range glyphs[letter] {
+			if i > 0 {
+				grid[y] = append(grid[y], false)
+			}
+			for _, c := range row {
+				grid[y] = append(grid[y], c == '#')
+			}
+		}
+	}
+	return grid
+}
+
+// gridFromStrings is a helper that returns a grid where '#' is a lit pixel.
+func gridFromStrings(rows ...string) Grid[bool] {
+	grid := make(Grid[bool], len(rows))
+	for y, row := range rows {
+		for _, c := range row {
+			grid[y] = append(grid[y], c == '#')
+		}
+	}
+	return grid
+}
+
+func TestOCR(t *testing.T) {
+	testCases := []struct {
+		name string
+		grid Grid[bool]
+		want string
+	}{
+		{"small alphabet", drawLetters("ABCEFGHIJKLOPRSUYZ", smallGlyphs, 6), "ABCEFGHIJKLOPRSUYZ"},
+		{"large alphabet", drawLetters("ABCEFGHJKLNPRXZ", largeGlyphs, 10), "ABCEFGHJKLNPRXZ"},
+		{"padding", gridFromStrings(
+			"..........",
+			"..#..#.....",
+			"..#..#.....",
+			"..####.....",
+			"..#..#.....",
+			"..#..#.....",
+			"..#..#.....",
+			"..........",
+		), "H"},
+		{"mixed letters", gridFromStrings(
+			"###..#....####.####.#..#.#....",
+			"#..#.#....#....#....#..#.#....",
+			"#..#.#....###..###..#..#.#....",
+			"###..#....#....#....#..#.#....",
+			"#....#....#....#....#..#.#....",
+			"#....####.####.#.....##..####.",
+		), "PLEFUL"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := OCR(tc.grid)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOCRErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		grid Grid[bool]
+		want string
+	}{
+		{"empty", Grid[bool]{}, "no lit pixels"},
+		{"blank", gridFromStrings("....", "...."), "no lit pixels"},
+		{"height", gridFromStrings("#", "#", "#"), "3 pixels high"},
+		{"unknown glyph", gridFromStrings(
+			"#..#.####",
+			"#..#.#...",
+			"####.###.",
+			"#..#.#...",
+			"#..#.#...",
+			"#..#.#.#.",
+		), "unknown glyph at column 5 after \"H\""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := OCR(tc.grid)
+			if err == nil {
+				t.Fatalf("expected error")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("got %q, want it to contain %q", err.Error(), tc.want)
+			}
+		})
+	}
+}
+
+func TestAnswer_StringOCR(t *testing.T) {
+	answer := NewPixelAnswer(drawLetters("EHZ", smallGlyphs, 6))
+	if got := answer.String(); got != "EHZ" {
+		t.Errorf("got %q, want %q", got, "EHZ")
+	}
+	if !answer.Equal(NewStringAnswer("EHZ")) {
+		t.Errorf("pixel answer should equal its letters")
+	}
+}
@@ -0,0 +1,263 @@
+// Package parse has helpers to parse puzzle inputs. Errors report the line and column where parsing failed.
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Error is an error at a position of the input. Line and Column start at 1, and are 0 when unknown.
+type Error struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *Error) Error() string {
+	switch {
+	case e.Line > 0 && e.Column > 0:
+		return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+	case e.Line > 0:
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	case e.Column > 0:
+		return fmt.Sprintf("column %d: %v", e.Column, e.Err)
+	}
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// errorAt is a function that returns an Error at a column of a line.
+func errorAt(column int, format string, args ...any) *Error {
+	return &Error{Column: column, Err: fmt.Errorf(format, args...)}
+}
+
+// AtLine is a function that sets the line of an error. Columns of Errors are kept,
+// other errors are wrapped in an Error. It returns nil when err is nil.
+func AtLine(line int, err error) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) && e.Line == 0 {
+		return &Error{Line: line, Column: e.Column, Err: e.Err}
+	}
+	return &Error{Line: line, Err: err}
+}
+
+// AtColumn is a function that moves the column of an error by offset, for errors in a part of a line
+// that starts at column offset+1. Other errors are wrapped in an Error at that column.
+func AtColumn(offset int, err error) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) && e.Column > 0 {
+		return &Error{Line: e.Line, Column: e.Column + offset, Err: e.Err}
+	}
+	return &Error{Column: offset + 1, Err: err}
+}
+
+// Lines is a function that parses each non-empty line with fn, reporting the line of errors.
+func Lines[T any](lines []string, fn func(string) (T, error)) ([]T, error) {
+	var values []T
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		v, err := fn(line)
+		if err != nil {
+			return nil, AtLine(i+1, err)
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// Sections is a function that splits lines into the groups separated by blank lines.
+// Runs of blank lines count as one separator and blank lines at the start or end are ignored.
+func Sections(lines []string) [][]string {
+	var sections [][]string
+	var section []string
+	for _, line := range lines {
+		if line == "" {
+			if len(section) > 0 {
+				sections = append(sections, section)
+				section = nil
+			}
+			continue
+		}
+		section = append(section, line)
+	}
+	if len(section) > 0 {
+		sections = append(sections, section)
+	}
+	return sections
+}
+
+// Int is a function that parses a whole string, ignoring surrounding spaces, as an int.
+func Int(s string) (int, error) {
+	trimmed := strings.TrimLeft(s, " \t")
+	column := len(s) - len(trimmed) + 1
+	trimmed = strings.TrimRight(trimmed, " \t")
+
+	n, err := strconv.Atoi(trimmed)
+	if err != nil {
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) && errors.Is(numErr.Err, strconv.ErrRange) {
+			return 0, errorAt(column, "%q is out of range", trimmed)
+		}
+		return 0, errorAt(column, "%q is not an integer", trimmed)
+	}
+	return n, nil
+}
+
+// isDigit is a function that checks if a byte is an ASCII digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// Ints is a function that returns all integers in a string, ignoring any other text.
+// A '-' right before digits is a sign unless it follows a digit, so "1-3" is 1 and 3.
+func Ints(s string) ([]int, error) {
+	var ints []int
+	for i := 0; i < len(s); i++ {
+		start := i
+		if s[i] == '-' && i+1 < len(s) && isDigit(s[i+1]) && (i == 0 || !isDigit(s[i-1])) {
+			i++
+		} else if !isDigit(s[i]) {
+			continue
+		}
+
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+
+		n, err := Int(s[start:i])
+		if err != nil {
+			return nil, AtColumn(start, err)
+		}
+		ints = append(ints, n)
+	}
+	return ints, nil
+}
+
+// IntList is a function that parses a list of integers separated by sep, or by spaces when sep is empty.
+// Unlike Ints, every item of the list must be an integer.
+func IntList(s, sep string) ([]int, error) {
+	var ints []int
+	for _, f := range split(s, sep) {
+		n, err := Int(f.text)
+		if err != nil {
+			return nil, AtColumn(f.offset, err)
+		}
+		ints = append(ints, n)
+	}
+	return ints, nil
+}
+
+// field is a part of a string and its offset in the string.
+type field struct {
+	text   string
+	offset int
+}
+
+// split is a function that splits s by sep, or into the words separated by spaces when sep is empty,
+// keeping the offset of each part.
+func split(s, sep string) []field {
+	var fields []field
+	if sep == "" {
+		for i := 0; i < len(s); i++ {
+			if s[i] == ' ' || s[i] == '\t' {
+				continue
+			}
+			start := i
+			for i < len(s) && s[i] != ' ' && s[i] != '\t' {
+				i++
+			}
+			fields = append(fields, field{s[start:i], start})
+		}
+		return fields
+	}
+
+	offset := 0
+	for _, text := range strings.Split(s, sep) {
+		fields = append(fields, field{text, offset})
+		offset += len(text) + len(sep)
+	}
+	return fields
+}
+
+// Fields is a function that parses a record of fields such as "x=1,m=2", with fields separated by sep
+// and keys separated from values by assign. Spaces around keys and values are removed.
+func Fields(s, sep, assign string) (map[string]string, error) {
+	fields := make(map[string]string)
+	for _, f := range split(s, sep) {
+		key, value, ok := strings.Cut(f.text, assign)
+		if !ok {
+			return nil, errorAt(f.offset+1, "field %q has no %q", f.text, assign)
+		}
+
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, errorAt(f.offset+1, "field %q has no key", f.text)
+		}
+		if _, ok := fields[key]; ok {
+			return nil, errorAt(f.offset+1, "field %q is repeated", key)
+		}
+		fields[key] = strings.TrimSpace(value)
+	}
+	return fields, nil
+}
+
+// IntFields is a function that parses a record of fields as Fields, where every value is an integer.
+func IntFields(s, sep, assign string) (map[string]int, error) {
+	if _, err := Fields(s, sep, assign); err != nil {
+		return nil, err
+	}
+
+	ints := make(map[string]int)
+	for _, f := range split(s, sep) {
+		key, value, _ := strings.Cut(f.text, assign)
+		n, err := Int(value)
+		if err != nil {
+			return nil, AtColumn(f.offset+len(key)+len(assign), err)
+		}
+		ints[strings.TrimSpace(key)] = n
+	}
+	return ints, nil
+}
+
+// Match is a function that returns the named groups of the first match of re in s.
+func Match(re *regexp.Regexp, s string) (map[string]string, error) {
+	match := re.FindStringSubmatch(s)
+	if match == nil {
+		return nil, fmt.Errorf("%q does not match %s", s, re)
+	}
+	return groups(re, match), nil
+}
+
+// MatchAll is a function that returns the named groups of every match of re in s.
+func MatchAll(re *regexp.Regexp, s string) []map[string]string {
+	var all []map[string]string
+	for _, match := range re.FindAllStringSubmatch(s, -1) {
+		all = append(all, groups(re, match))
+	}
+	return all
+}
+
+// groups is a function that returns the named groups of a match.
+func groups(re *regexp.Regexp, match []string) map[string]string {
+	named := make(map[string]string)
+	for i, name := range re.SubexpNames() {
+		if name != "" {
+			named[name] = match[i]
+		}
+	}
+	return named
+}
@@ -0,0 +1,268 @@
+package parse
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestError_Error(t *testing.T) {
+	err := errors.New("bad")
+	testCases := []struct {
+		err  *Error
+		want string
+	}{
+		{&Error{Line: 2, Column: 3, Err: err}, "line 2, column 3: bad"},
+		{&Error{Line: 2, Err: err}, "line 2: bad"},
+		{&Error{Column: 3, Err: err}, "column 3: bad"},
+		{&Error{Err: err}, "bad"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.want, func(t *testing.T) {
+			if got := tc.err.Error(); got != tc.want {
+				t.Errorf("Error() = %q, want %q", got, tc.want)
+			}
+			if !errors.Is(tc.err, err) {
+				t.Errorf("Error should unwrap to %v", err)
+			}
+		})
+	}
+}
+
+func TestAtLineAndColumn(t *testing.T) {
+	base := errors.New("bad")
+
+	if AtLine(1, nil) != nil || AtColumn(1, nil) != nil {
+		t.Errorf("nil errors should stay nil")
+	}
+
+	testCases := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"line of plain error", AtLine(4, base), "line 4: bad"},
+		{"line keeps column", AtLine(4, &Error{Column: 2, Err: base}), "line 4, column 2: bad"},
+		{"line is not replaced", AtLine(4, &Error{Line: 1, Err: base}), "line 4: line 1: bad"},
+		{"column of plain error", AtColumn(5, base), "column 6: bad"},
+		{"column is moved", AtColumn(5, &Error{Column: 2, Err: base}), "column 7: bad"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.err.Error(); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLines(t *testing.T) {
+	got, err := Lines([]string{"1", "", "2"}, strconv.Atoi)
+	if err != nil {
+		t.Fatalf("Lines() unexpected error: %v", err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines() = %v, want %v", got, want)
+	}
+
+	_, err = Lines([]string{"1 2", "", "3 x"}, func(s string) ([]int, error) { return IntList(s, " ") })
+	if err == nil || err.Error() != `line 3, column 3: "x" is not an integer` {
+		t.Errorf("Lines() error = %v", err)
+	}
+}
+
+func TestSections(t *testing.T) {
+	testCases := []struct {
+		name  string
+		lines []string
+		want  [][]string
+	}{
+		{"empty", nil, nil},
+		{"one", []string{"a", "b"}, [][]string{{"a", "b"}}},
+		{"two", []string{"a", "", "b", "c"}, [][]string{{"a"}, {"b", "c"}}},
+		{"extra blank lines", []string{"", "a", "", "", "b", ""}, [][]string{{"a"}, {"b"}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Sections(tc.lines); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Sections() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestInt(t *testing.T) {
+	testCases := []struct {
+		input   string
+		want    int
+		wantErr string
+	}{
+		{"42", 42, ""},
+		{" -7 ", -7, ""},
+		{"+3", 3, ""},
+		{"  x1", 0, `column 3: "x1" is not an integer`},
+		{"", 0, `column 1: "" is not an integer`},
+		{"99999999999999999999", 0, `column 1: "99999999999999999999" is out of range`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := Int(tc.input)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Errorf("Int() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tc.want {
+				t.Errorf("Int() = %d, %v, want %d", got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestInts(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  []int
+	}{
+		{"", nil},
+		{"no numbers", nil},
+		{"Card 1: 41 48 | 83 86", []int{1, 41, 48, 83, 86}},
+		{"10 13 -4 -21", []int{10, 13, -4, -21}},
+		{"1-3", []int{1, 3}},
+		{"x=-2, y=5", []int{-2, 5}},
+		{"a - 2", []int{2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := Ints(tc.input)
+			if err != nil {
+				t.Fatalf("Ints() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Ints() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if _, err := Ints("ok 99999999999999999999"); err == nil || err.Error() != `column 4: "99999999999999999999" is out of range` {
+		t.Errorf("Ints() error = %v", err)
+	}
+}
+
+func TestIntList(t *testing.T) {
+	testCases := []struct {
+		input   string
+		sep     string
+		want    []int
+		wantErr string
+	}{
+		{"0 3  6 -9", "", []int{0, 3, 6, -9}, ""},
+		{"1,2, 3", ",", []int{1, 2, 3}, ""},
+		{"1 two 3", "", nil, `column 3: "two" is not an integer`},
+		{"1,,3", ",", nil, `column 3: "" is not an integer`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := IntList(tc.input, tc.sep)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Errorf("IntList() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil || !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("IntList() = %v, %v, want %v", got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestFields(t *testing.T) {
+	got, err := Fields("name = AAA, left=BBB", ",", "=")
+	if err != nil {
+		t.Fatalf("Fields() unexpected error: %v", err)
+	}
+	if want := map[string]string{"name": "AAA", "left": "BBB"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields() = %v, want %v", got, want)
+	}
+
+	testCases := []struct {
+		input   string
+		wantErr string
+	}{
+		{"a=1,b", `column 5: field "b" has no "="`},
+		{"a=1,=2", `column 5: field "=2" has no key`},
+		{"a=1,a=2", `column 5: field "a" is repeated`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			if _, err := Fields(tc.input, ",", "="); err == nil || err.Error() != tc.wantErr {
+				t.Errorf("Fields() error = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestIntFields(t *testing.T) {
+	got, err := IntFields("x=787,m=2655,a=1222,s=-2", ",", "=")
+	if err != nil {
+		t.Fatalf("IntFields() unexpected error: %v", err)
+	}
+	if want := map[string]int{"x": 787, "m": 2655, "a": 1222, "s": -2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("IntFields() = %v, want %v", got, want)
+	}
+
+	if _, err := IntFields("x=1,m=two", ",", "="); err == nil || err.Error() != `column 7: "two" is not an integer` {
+		t.Errorf("IntFields() error = %v", err)
+	}
+	if _, err := IntFields("x=1,m", ",", "="); err == nil {
+		t.Errorf("IntFields() expected error for a field without value")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	re := regexp.MustCompile(`(?P<name>\w+) = \((?P<left>\w+), (?P<right>\w+)\)`)
+
+	got, err := Match(re, "AAA = (BBB, CCC)")
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if want := map[string]string{"name": "AAA", "left": "BBB", "right": "CCC"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Match() = %v, want %v", got, want)
+	}
+
+	if _, err := Match(re, "AAA"); err == nil {
+		t.Errorf("Match() expected error")
+	}
+}
+
+func TestMatchAll(t *testing.T) {
+	re := regexp.MustCompile(`(?P<count>\d+) (?P<color>\w+)`)
+
+	got := MatchAll(re, "3 blue, 4 red")
+	want := []map[string]string{{"count": "3", "color": "blue"}, {"count": "4", "color": "red"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MatchAll() = %v, want %v", got, want)
+	}
+
+	if got := MatchAll(re, "none"); got != nil {
+		t.Errorf("MatchAll() = %v, want nil", got)
+	}
+}
+
+func FuzzInts(f *testing.F) {
+	f.Add("Card 1: 41 48 | 83 86 -3")
+	f.Fuzz(func(t *testing.T, input string) {
+		_, _ = Ints(input)
+	})
+}
@@ -0,0 +1,216 @@
+package parse
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// decoder is the regular expression to match a struct type and the fields its groups are decoded into.
+type decoder struct {
+	re     *regexp.Regexp
+	fields []decoderField
+}
+
+// decoderField is a field of a struct decoded from a group.
+type decoderField struct {
+	index int
+	name  string
+	sep   string
+}
+
+var decoders sync.Map // reflect.Type -> *decoder
+
+// newDecoder is a function that returns the decoder of a struct type, built from the parse tags of its fields.
+func newDecoder(t reflect.Type) (*decoder, error) {
+	if d, ok := decoders.Load(t); ok {
+		return d.(*decoder), nil
+	}
+
+	var expr strings.Builder
+	var fields []decoderField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup("parse")
+		if !ok || tag == "-" {
+			continue
+		}
+		if !f.IsExported() {
+			return nil, fmt.Errorf("parse: field %s.%s with a parse tag must be exported", t.Name(), f.Name)
+		}
+		expr.WriteString(tag)
+		fields = append(fields, decoderField{index: i, name: f.Name, sep: f.Tag.Get("sep")})
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("parse: struct %s has no fields with a parse tag", t.Name())
+	}
+
+	re, err := regexp.Compile("^(?:" + expr.String() + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("parse: invalid tags of %s: %w", t.Name(), err)
+	}
+	if re.NumSubexp() != len(fields) {
+		return nil, fmt.Errorf("parse: tags of %s have %d groups for %d fields, use (?:) for groups that are not fields",
+			t.Name(), re.NumSubexp(), len(fields))
+	}
+
+	d := &decoder{re: re, fields: fields}
+	decoders.Store(t, d)
+	return d, nil
+}
+
+// Unmarshal is a function that decodes each non-empty line into an element of the slice dst points to.
+// Errors report the line and column of the text that could not be decoded. See UnmarshalLine for the format.
+func Unmarshal(lines []string, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("parse: Unmarshal needs a pointer to a slice, got %T", dst)
+	}
+
+	slice := v.Elem()
+	elemType := slice.Type().Elem()
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		elem := reflect.New(elemType).Elem()
+		if err := decode(elem, line, ""); err != nil {
+			return AtLine(i+1, err)
+		}
+		slice.Set(reflect.Append(slice, elem))
+	}
+	return nil
+}
+
+// UnmarshalLine is a function that decodes a line into the struct dst points to.
+//
+// Each field to decode has a parse tag with a part of a regular expression that has one group, the text of the field.
+// As in any struct tag, backslashes in the expression are escaped.
+// The parts of all fields, in order, must match the whole line. Groups that are not fields must be non-capturing,
+// and a field whose group does not take part in the match keeps its zero value. For example
+//
+//	type rule struct {
+//		N    int `parse:"move (\\d+)"`
+//		From int `parse:" from (\\d+)"`
+//		To   int `parse:" to (\\d+)"`
+//	}
+//
+// decodes "move 1 from 2 to 3". Fields can be strings, booleans, numbers, types implementing
+// encoding.TextUnmarshaler, structs that are decoded the same way, and slices of those.
+// Slices are split by their sep tag, or by spaces without one, and spaces around the items are removed.
+func UnmarshalLine(line string, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("parse: UnmarshalLine needs a pointer to a struct, got %T", dst)
+	}
+	return decode(v.Elem(), line, "")
+}
+
+// textUnmarshalerType is the type of the encoding.TextUnmarshaler interface.
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// decode is a function that sets v from text. sep splits text when v is a slice.
+func decode(v reflect.Value, text, sep string) error {
+	if v.CanAddr() && v.Addr().Type().Implements(textUnmarshalerType) {
+		if err := v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text)); err != nil {
+			return errorAt(1, "%w", err)
+		}
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := Int(text)
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(int64(n)) {
+			return errorAt(1, "%q is out of range of %s", text, v.Type())
+		}
+		v.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(strings.TrimSpace(text), 10, v.Type().Bits())
+		if err != nil {
+			return errorAt(1, "%q is not a %s", text, v.Type())
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(strings.TrimSpace(text), v.Type().Bits())
+		if err != nil {
+			return errorAt(1, "%q is not a %s", text, v.Type())
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(text))
+		if err != nil {
+			return errorAt(1, "%q is not a bool", text)
+		}
+		v.SetBool(b)
+	case reflect.Struct:
+		return decodeStruct(v, text)
+	case reflect.Slice:
+		return decodeSlice(v, text, sep)
+	default:
+		return fmt.Errorf("parse: can not decode into %s", v.Type())
+	}
+	return nil
+}
+
+// decodeStruct is a function that sets the tagged fields of a struct from the groups of its decoder.
+func decodeStruct(v reflect.Value, text string) error {
+	d, err := newDecoder(v.Type())
+	if err != nil {
+		return err
+	}
+
+	match := d.re.FindStringSubmatchIndex(text)
+	if match == nil {
+		return errorAt(1, "%q does not match %s", text, d.re)
+	}
+
+	for i, f := range d.fields {
+		start, end := match[2*i+2], match[2*i+3]
+		if start < 0 {
+			continue
+		}
+		if err := decode(v.Field(f.index), text[start:end], f.sep); err != nil {
+			return AtColumn(start, fieldError(f.name, err))
+		}
+	}
+	return nil
+}
+
+// fieldError is a function that adds the name of the field that could not be decoded to an error,
+// keeping its position.
+func fieldError(name string, err error) error {
+	if e, ok := err.(*Error); ok {
+		return &Error{Line: e.Line, Column: e.Column, Err: fmt.Errorf("%s: %w", name, e.Err)}
+	}
+	return fmt.Errorf("%s: %w", name, err)
+}
+
+// decodeSlice is a function that sets a slice from the items of text separated by sep.
+func decodeSlice(v reflect.Value, text, sep string) error {
+	slice := reflect.MakeSlice(v.Type(), 0, 0)
+	if strings.TrimSpace(text) != "" {
+		for _, f := range split(text, sep) {
+			item := strings.TrimSpace(f.text)
+			offset := f.offset + strings.Index(f.text, item)
+
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := decode(elem, item, ""); err != nil {
+				return AtColumn(offset, err)
+			}
+			slice = reflect.Append(slice, elem)
+		}
+	}
+	v.Set(slice)
+	return nil
+}
@@ -0,0 +1,176 @@
+package parse
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type move struct {
+	N    int `parse:"move (\\d+)"`
+	From int `parse:" from (\\d+)"`
+	To   int `parse:" to (\\d+)"`
+}
+
+type cubes struct {
+	Count int    `parse:"(\\d+) "`
+	Color string `parse:"(red|green|blue)"`
+}
+
+type round struct {
+	Cubes []cubes `parse:"(.*)" sep:","`
+}
+
+type game struct {
+	ID     int     `parse:"Game (\\d+): "`
+	Rounds []round `parse:"(.*)" sep:";"`
+}
+
+// heading is a direction decoded from a letter.
+type heading int
+
+func (h *heading) UnmarshalText(text []byte) error {
+	i := strings.Index("URDL", string(text))
+	if len(text) != 1 || i < 0 {
+		return fmt.Errorf("invalid heading %q", text)
+	}
+	*h = heading(i)
+	return nil
+}
+
+type step struct {
+	Heading heading `parse:"(\\w) "`
+	Length  uint8   `parse:"(\\d+)"`
+	Comment string  `parse:"(?: # (.*))?"`
+	Ignored string
+}
+
+type comparison struct {
+	Part      string `parse:"(\\w+)"`
+	Operation string `parse:"([<>])"`
+	Value     int    `parse:"(-?\\d+)"`
+}
+
+type condition struct {
+	If comparison `parse:"(?:(\\w+[<>]-?\\d+):)?"`
+	To string     `parse:"(\\w+)"`
+}
+
+type mixed struct {
+	Name   string   `parse:"(\\w+)"`
+	Ratio  float64  `parse:" (\\S+)"`
+	Active bool     `parse:" (\\w+)"`
+	Tags   []string `parse:" \\[(.*)\\]" sep:","`
+	Ints   []int    `parse:" (.*)"`
+}
+
+func TestUnmarshal(t *testing.T) {
+	var moves []move
+	if err := Unmarshal([]string{"move 1 from 2 to 1", "", "move 3 from 1 to 3"}, &moves); err != nil {
+		t.Fatalf("Unmarshal() unexpected error: %v", err)
+	}
+	if want := []move{{1, 2, 1}, {3, 1, 3}}; !reflect.DeepEqual(moves, want) {
+		t.Errorf("Unmarshal() = %v, want %v", moves, want)
+	}
+
+	err := Unmarshal([]string{"move 1 from 2 to 1", "move 1 from x to 1"}, &moves)
+	if err == nil || !strings.HasPrefix(err.Error(), "line 2, column 1: ") {
+		t.Errorf("Unmarshal() error = %v", err)
+	}
+
+	var notSlice move
+	if err := Unmarshal(nil, &notSlice); err == nil {
+		t.Errorf("Unmarshal() expected error for a pointer to a struct")
+	}
+}
+
+func TestUnmarshalLine(t *testing.T) {
+	testCases := []struct {
+		name string
+		line string
+		dst  any
+		want any
+	}{
+		{"nested slices", "Game 3: 3 blue, 4 red; 1 red; ", &game{}, &game{ID: 3, Rounds: []round{
+			{Cubes: []cubes{{3, "blue"}, {4, "red"}}},
+			{Cubes: []cubes{{1, "red"}}},
+			{Cubes: []cubes{}},
+		}}},
+		{"text unmarshaler", "D 6", &step{}, &step{Heading: 2, Length: 6}},
+		{"optional group", "L 2 # back", &step{}, &step{Heading: 3, Length: 2, Comment: "back"}},
+		{"optional condition", "a<-2006:qkq", &condition{}, &condition{comparison{"a", "<", -2006}, "qkq"}},
+		{"missing optional condition", "rfg", &condition{}, &condition{To: "rfg"}},
+		{"kinds", "x 0.5 true [a, b] 1 -2", &mixed{}, &mixed{"x", 0.5, true, []string{"a", "b"}, []int{1, -2}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := UnmarshalLine(tc.line, tc.dst); err != nil {
+				t.Fatalf("UnmarshalLine() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.dst, tc.want) {
+				t.Errorf("UnmarshalLine() = %+v, want %+v", tc.dst, tc.want)
+			}
+		})
+	}
+}
+
+type noTags struct {
+	A int
+}
+
+type extraGroup struct {
+	A string `parse:"(a)(b)"`
+}
+
+type badRegex struct {
+	A string `parse:"(a"`
+}
+
+type unexported struct {
+	a string `parse:"(a)"`
+}
+
+type unsupported struct {
+	A map[string]int `parse:"(.*)"`
+}
+
+func TestUnmarshalLineErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		line string
+		dst  any
+		want string
+	}{
+		{"not a pointer", "a", game{}, "needs a pointer to a struct"},
+		{"no match", "Game x: 1 red", &game{}, `column 1: "Game x: 1 red" does not match`},
+		{"nested column", "Game 1: 1 red; 2 pink", &game{}, "column 16: Rounds: Cubes: \"2 pink\" does not match"},
+		{"int column", "x 0.5 true [] 1 2 z", &mixed{}, `column 19: Ints: "z" is not an integer`},
+		{"overflow", "U 300", &step{}, `column 3: Length: "300" is not a uint8`},
+		{"text unmarshaler", "X 1", &step{}, `column 1: Heading: invalid heading "X"`},
+		{"float", "x y true [] 1", &mixed{}, `column 3: Ratio: "y" is not a float64`},
+		{"no tags", "a", &noTags{}, "has no fields with a parse tag"},
+		{"extra group", "ab", &extraGroup{}, "have 2 groups for 1 fields"},
+		{"bad regex", "a", &badRegex{}, "invalid tags of badRegex"},
+		{"unexported", "a", &unexported{}, "must be exported"},
+		{"unsupported", "a", &unsupported{}, "can not decode into map[string]int"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := UnmarshalLine(tc.line, tc.dst)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("UnmarshalLine() error = %v, want it to contain %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func FuzzUnmarshalLine(f *testing.F) {
+	f.Add("Game 1: 3 blue, 4 red; 1 red, 2 green, 6 blue; 2 green")
+	f.Fuzz(func(t *testing.T, input string) {
+		var g game
+		_ = UnmarshalLine(input, &g)
+	})
+}
@@ -0,0 +1,134 @@
+package utils
+
+import "fmt"
+
+// Point3 is a struct that represents a point in a 3D space.
+type Point3 struct {
+	X, Y, Z int
+}
+
+// String is a method that returns a string representation of a Point3.
+func (p Point3) String() string {
+	return fmt.Sprintf("(%d, %d, %d)", p.X, p.Y, p.Z)
+}
+
+// Add is a method that adds a Point3 to another Point3.
+func (p Point3) Add(p2 Point3) Point3 {
+	return Point3{p.X + p2.X, p.Y + p2.Y, p.Z + p2.Z}
+}
+
+// Sub is a method that subtracts a Point3 from another Point3.
+func (p Point3) Sub(p2 Point3) Point3 {
+	return Point3{p.X - p2.X, p.Y - p2.Y, p.Z - p2.Z}
+}
+
+// Scale is a method that multiplies every coordinate of a Point3 by n.
+func (p Point3) Scale(n int) Point3 {
+	return Point3{p.X * n, p.Y * n, p.Z * n}
+}
+
+// Manhattan is a method that returns the Manhattan distance between two Point3s, the sum of the distances along each axis.
+func (p Point3) Manhattan(p2 Point3) int {
+	return Abs(p.X-p2.X) + Abs(p.Y-p2.Y) + Abs(p.Z-p2.Z)
+}
+
+// Chebyshev is a method that returns the Chebyshev distance between two Point3s, the largest of the distances along each axis.
+func (p Point3) Chebyshev(p2 Point3) int {
+	return max(Abs(p.X-p2.X), Abs(p.Y-p2.Y), Abs(p.Z-p2.Z))
+}
+
+// Vector is a method that returns the coordinates of a Point3 as a Vector.
+func (p Point3) Vector() Vector[int] {
+	return Vector[int]{p.X, p.Y, p.Z}
+}
+
+// Neighbours6 is a method that returns the neighbours of a Point3 that share a face with it.
+func (p Point3) Neighbours6() []Point3 {
+	return []Point3{
+		{p.X - 1, p.Y, p.Z}, {p.X + 1, p.Y, p.Z},
+		{p.X, p.Y - 1, p.Z}, {p.X, p.Y + 1, p.Z},
+		{p.X, p.Y, p.Z - 1}, {p.X, p.Y, p.Z + 1},
+	}
+}
+
+// Neighbours26 is a method that returns all neighbours of a Point3, including those that only share an edge or a corner.
+// Neighbours are ordered by z, then y, then x.
+func (p Point3) Neighbours26() []Point3 {
+	neighbours := make([]Point3, 0, 26)
+	for dz := -1; dz <= 1; dz++ {
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				if dx == 0 && dy == 0 && dz == 0 {
+					continue
+				}
+				neighbours = append(neighbours, Point3{p.X + dx, p.Y + dy, p.Z + dz})
+			}
+		}
+	}
+	return neighbours
+}
+
+// Box3 is a struct that represents the box of points with Min.X <= X < Max.X, Min.Y <= Y < Max.Y and Min.Z <= Z < Max.Z.
+type Box3 struct {
+	Min, Max Point3
+}
+
+// BoxAround is a function that returns the smallest Box3 containing every point.
+// Puzzles usually give boxes by their two opposite corners, which are both inside it.
+func BoxAround(points ...Point3) Box3 {
+	if len(points) == 0 {
+		return Box3{}
+	}
+
+	b := Box3{Min: points[0], Max: points[0].Add(Point3{1, 1, 1})}
+	for _, p := range points[1:] {
+		b.Min = Point3{min(b.Min.X, p.X), min(b.Min.Y, p.Y), min(b.Min.Z, p.Z)}
+		b.Max = Point3{max(b.Max.X, p.X+1), max(b.Max.Y, p.Y+1), max(b.Max.Z, p.Z+1)}
+	}
+	return b
+}
+
+// Size is a method that returns the length of a Box3 along each axis.
+func (b Box3) Size() Point3 {
+	return Point3{max(b.Max.X-b.Min.X, 0), max(b.Max.Y-b.Min.Y, 0), max(b.Max.Z-b.Min.Z, 0)}
+}
+
+// Volume is a method that returns the number of points inside a Box3.
+func (b Box3) Volume() int {
+	size := b.Size()
+	return size.X * size.Y * size.Z
+}
+
+// Empty is a method that checks if a Box3 has no points inside it.
+func (b Box3) Empty() bool {
+	return b.Volume() == 0
+}
+
+// Contains is a method that checks if a Point3 is inside a Box3.
+func (b Box3) Contains(p Point3) bool {
+	return p.X >= b.Min.X && p.X < b.Max.X &&
+		p.Y >= b.Min.Y && p.Y < b.Max.Y &&
+		p.Z >= b.Min.Z && p.Z < b.Max.Z
+}
+
+// Intersect is a method that returns the largest Box3 contained by both Box3s.
+// Boxes that do not overlap intersect in an empty Box3.
+func (b Box3) Intersect(other Box3) Box3 {
+	b.Min = Point3{max(b.Min.X, other.Min.X), max(b.Min.Y, other.Min.Y), max(b.Min.Z, other.Min.Z)}
+	b.Max = Point3{
+		max(min(b.Max.X, other.Max.X), b.Min.X),
+		max(min(b.Max.Y, other.Max.Y), b.Min.Y),
+		max(min(b.Max.Z, other.Max.Z), b.Min.Z),
+	}
+	return b
+}
+
+// Overlaps is a method that checks if two Box3s have any point in common.
+func (b Box3) Overlaps(other Box3) bool {
+	return !b.Intersect(other).Empty()
+}
+
+// Translate is a method that returns a Box3 moved by an offset.
+func (b Box3) Translate(offset Point3) Box3 {
+	return Box3{b.Min.Add(offset), b.Max.Add(offset)}
+}
@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPoint3_Arithmetic(t *testing.T) {
+	p, q := Point3{1, 2, 3}, Point3{-4, 6, 0}
+
+	if got, want := p.Add(q), (Point3{-3, 8, 3}); got != want {
+		t.Errorf("Add() = %v, want %v", got, want)
+	}
+	if got, want := p.Sub(q), (Point3{5, -4, 3}); got != want {
+		t.Errorf("Sub() = %v, want %v", got, want)
+	}
+	if got, want := p.Scale(-2), (Point3{-2, -4, -6}); got != want {
+		t.Errorf("Scale() = %v, want %v", got, want)
+	}
+	if got, want := p.String(), "(1, 2, 3)"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+	if got, want := p.Vector(), (Vector[int]{1, 2, 3}); !got.Equal(want) {
+		t.Errorf("Vector() = %v, want %v", got, want)
+	}
+}
+
+func TestPoint3_Distances(t *testing.T) {
+	testCases := []struct {
+		p1, p2    Point3
+		manhattan int
+		chebyshev int
+	}{
+		{Point3{0, 0, 0}, Point3{0, 0, 0}, 0, 0},
+		{Point3{1, 2, 3}, Point3{-4, 6, 0}, 12, 5},
+		{Point3{1, 1, 1}, Point3{2, 2, 2}, 3, 1},
+		{Point3{-(1 << 55), 0, 0}, Point3{1 << 55, 0, 1}, 1<<56 + 1, 1 << 56},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%v-%v", tc.p1, tc.p2), func(t *testing.T) {
+			if got := tc.p1.Manhattan(tc.p2); got != tc.manhattan {
+				t.Errorf("Manhattan() = %v, want %v", got, tc.manhattan)
+			}
+			if got := tc.p1.Chebyshev(tc.p2); got != tc.chebyshev {
+				t.Errorf("Chebyshev() = %v, want %v", got, tc.chebyshev)
+			}
+		})
+	}
+}
+
+func TestPoint3_Neighbours(t *testing.T) {
+	p := Point3{5, -2, 7}
+
+	testCases := []struct {
+		name       string
+		neighbours []Point3
+		want       int
+		distance   func(Point3) int
+	}{
+		{"6", p.Neighbours6(), 6, p.Manhattan},
+		{"26", p.Neighbours26(), 26, p.Chebyshev},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if len(tc.neighbours) != tc.want {
+				t.Fatalf("got %d neighbours, want %d", len(tc.neighbours), tc.want)
+			}
+
+			seen := make(map[Point3]bool)
+			for _, n := range tc.neighbours {
+				if tc.distance(n) != 1 {
+					t.Errorf("neighbour %v is not one step from %v", n, p)
+				}
+				if seen[n] {
+					t.Errorf("neighbour %v is repeated", n)
+				}
+				seen[n] = true
+			}
+		})
+	}
+}
+
+func TestBoxAround(t *testing.T) {
+	testCases := []struct {
+		points []Point3
+		want   Box3
+	}{
+		{nil, Box3{}},
+		{[]Point3{{1, 2, 3}}, Box3{Point3{1, 2, 3}, Point3{2, 3, 4}}},
+		{[]Point3{{2, 0, 5}, {0, 0, 1}}, Box3{Point3{0, 0, 1}, Point3{3, 1, 6}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.points), func(t *testing.T) {
+			got := BoxAround(tc.points...)
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			for _, p := range tc.points {
+				if !got.Contains(p) {
+					t.Errorf("box %v does not contain %v", got, p)
+				}
+			}
+		})
+	}
+}
+
+func TestBox3(t *testing.T) {
+	// A brick from (2, 0, 5) to (2, 2, 5), both ends included
+	brick := BoxAround(Point3{2, 0, 5}, Point3{2, 2, 5})
+	// A flat 3x3 square one level below
+	floor := BoxAround(Point3{0, 0, 4}, Point3{2, 2, 4})
+
+	if got := brick.Volume(); got != 3 {
+		t.Errorf("brick.Volume() = %d, want 3", got)
+	}
+	if got := floor.Volume(); got != 9 {
+		t.Errorf("floor.Volume() = %d, want 9", got)
+	}
+	if got, want := brick.Size(), (Point3{1, 3, 1}); got != want {
+		t.Errorf("brick.Size() = %v, want %v", got, want)
+	}
+
+	if brick.Overlaps(floor) {
+		t.Errorf("brick above the floor should not overlap it")
+	}
+	if got := brick.Intersect(floor); !got.Empty() {
+		t.Errorf("brick.Intersect(floor) = %v, want an empty box", got)
+	}
+
+	fallen := brick.Translate(Point3{0, 0, -1})
+	if !fallen.Overlaps(floor) {
+		t.Errorf("fallen brick %v should overlap the floor %v", fallen, floor)
+	}
+	if got, want := fallen.Intersect(floor), BoxAround(Point3{2, 0, 4}, Point3{2, 2, 4}); got != want {
+		t.Errorf("fallen.Intersect(floor) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,163 @@
+package prop
+
+import (
+	"math/rand"
+
+	"github.com/iamlucasvieira/aoc/utils"
+)
+
+// Int returns a generator of integers in [min, max].
+func Int(min, max int) Generator[int] {
+	return func(r *rand.Rand, _ int) int {
+		return min + r.Intn(max-min+1)
+	}
+}
+
+// Slice returns a generator of slices with length in [minLen, max(minLen, size)].
+func Slice[T any](elem Generator[T], minLen int) Generator[[]T] {
+	return func(r *rand.Rand, size int) []T {
+		n := minLen
+		if size > minLen {
+			n += r.Intn(size - minLen + 1)
+		}
+
+		s := make([]T, n)
+		for i := range s {
+			s[i] = elem(r, size)
+		}
+		return s
+	}
+}
+
+// Point returns a generator of points with coordinates in [-size, size].
+func Point() Generator[utils.Point] {
+	return func(r *rand.Rand, size int) utils.Point {
+		return utils.Point{X: r.Intn(2*size+1) - size, Y: r.Intn(2*size+1) - size}
+	}
+}
+
+// Grid returns a generator of grids with width and height in [1, size] and cells produced by cell.
+func Grid[T any](cell Generator[T]) Generator[utils.Grid[T]] {
+	return func(r *rand.Rand, size int) utils.Grid[T] {
+		width, height := 1+r.Intn(size), 1+r.Intn(size)
+
+		g := make(utils.Grid[T], height)
+		for y := range g {
+			g[y] = make([]T, width)
+			for x := range g[y] {
+				g[y][x] = cell(r, size)
+			}
+		}
+		return g
+	}
+}
+
+// Polygon returns a generator of closed polygons made of unit steps, as the ones walked by a loop in a grid.
+// The polygon starts and ends at the same point, never touches itself and all its points have coordinates
+// in [1, 2*size+1]. A grid of width and height 2*size+3 contains the polygon and a border around it.
+//
+// The shape is built from one interval per row, where consecutive intervals overlap. The coordinates are
+// doubled so that different sides of the polygon are never next to each other.
+func Polygon() Generator[[]utils.Point] {
+	return func(r *rand.Rand, size int) []utils.Point {
+		width, height := 1+r.Intn(size), 1+r.Intn(size)
+
+		// left[y] and right[y] are the edges of the half-open interval of row y
+		left, right := make([]int, height), make([]int, height)
+		left[0] = r.Intn(width)
+		right[0] = left[0] + 1 + r.Intn(width-left[0])
+		for y := 1; y < height; y++ {
+			left[y] = r.Intn(right[y-1])
+			lowest := max(left[y], left[y-1]) + 1
+			right[y] = lowest + r.Intn(width-lowest+1)
+		}
+
+		// Corners of the shape walked clockwise, starting at the top left corner
+		corners := []utils.Point{{X: left[0], Y: 0}}
+		for y := 0; y < height; y++ {
+			corners = append(corners, utils.Point{X: right[y], Y: y}, utils.Point{X: right[y], Y: y + 1})
+		}
+		for y := height - 1; y >= 0; y-- {
+			corners = append(corners, utils.Point{X: left[y], Y: y + 1}, utils.Point{X: left[y], Y: y})
+		}
+
+		// Walk between the scaled corners in unit steps
+		var polygon []utils.Point
+		for i := 0; i < len(corners)-1; i++ {
+			from := corners[i].Mul(utils.Point{X: 2, Y: 2}).Add(utils.Point{X: 1, Y: 1})
+			to := corners[i+1].Mul(utils.Point{X: 2, Y: 2}).Add(utils.Point{X: 1, Y: 1})
+			step := utils.Point{X: sign(to.X - from.X), Y: sign(to.Y - from.Y)}
+			for p := from; p != to; p = p.Add(step) {
+				polygon = append(polygon, p)
+			}
+		}
+
+		// Start at a random point of the polygon, so the start is not always a corner
+		start := r.Intn(len(polygon))
+		polygon = append(polygon[start:], polygon[:start]...)
+		return append(polygon, polygon[0])
+	}
+}
+
+// ShrinkInt returns integers closer to zero than n.
+func ShrinkInt(n int) []int {
+	var candidates []int
+	for d := n / 2; d != 0; d /= 2 {
+		candidates = append(candidates, n-d)
+	}
+	if n != 0 {
+		candidates = append([]int{0}, candidates...)
+	}
+	return candidates
+}
+
+// ShrinkPoint returns points closer to the origin than p.
+func ShrinkPoint(p utils.Point) []utils.Point {
+	var candidates []utils.Point
+	for _, x := range ShrinkInt(p.X) {
+		candidates = append(candidates, utils.Point{X: x, Y: p.Y})
+	}
+	for _, y := range ShrinkInt(p.Y) {
+		candidates = append(candidates, utils.Point{X: p.X, Y: y})
+	}
+	return candidates
+}
+
+// ShrinkSlice returns a Shrinker that removes elements from a slice, keeping at least minLen of them,
+// and then shrinks each element with elem.
+func ShrinkSlice[T any](elem Shrinker[T], minLen int) Shrinker[[]T] {
+	return func(s []T) [][]T {
+		var candidates [][]T
+		for i := range s {
+			if len(s) <= minLen {
+				break
+			}
+			candidate := append(append([]T{}, s[:i]...), s[i+1:]...)
+			candidates = append(candidates, candidate)
+		}
+
+		if elem == nil {
+			return candidates
+		}
+
+		for i, v := range s {
+			for _, shrunk := range elem(v) {
+				candidate := append([]T{}, s...)
+				candidate[i] = shrunk
+				candidates = append(candidates, candidate)
+			}
+		}
+		return candidates
+	}
+}
+
+// sign returns -1, 0 or 1 depending on the sign of n.
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
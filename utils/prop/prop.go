@@ -0,0 +1,129 @@
+// Package prop is a small property based testing helper for the utils package.
+// Values are produced by generators and, when a property fails, reduced by a shrinker
+// to the smallest input that still makes the property fail.
+package prop
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// SeedEnv is the environment variable that sets the seed used when a Config has none.
+// It takes a number, or "random" for a new seed on every run. Without it checks use DefaultSeed,
+// so test runs are reproducible.
+const SeedEnv = "AOC_PROP_SEED"
+
+// DefaultSeed is the seed used when neither the Config nor SeedEnv sets one.
+const DefaultSeed int64 = 1
+
+// Generator returns a random value of T. size bounds how large the value can get.
+type Generator[T any] func(r *rand.Rand, size int) T
+
+// Shrinker returns candidates that are smaller than the given value.
+type Shrinker[T any] func(T) []T
+
+// Config defines how many values are checked and how large they can get.
+type Config struct {
+	MaxCount int   // Number of values checked, defaults to 100
+	MaxSize  int   // Maximum size passed to the generator, defaults to 20
+	Seed     int64 // Seed of the random source, defaults to SeedEnv or DefaultSeed
+}
+
+// defaultConfig is the Config used by Check and CheckShrink.
+var defaultConfig = Config{MaxCount: 100, MaxSize: 20}
+
+// Check checks that property holds for values produced by gen.
+func Check[T any](t testing.TB, gen Generator[T], property func(T) bool) {
+	t.Helper()
+	CheckConfig(t, defaultConfig, gen, nil, property)
+}
+
+// CheckShrink checks that property holds for values produced by gen.
+// When the property fails, the failing value is shrunk before being reported.
+func CheckShrink[T any](t testing.TB, gen Generator[T], shrink Shrinker[T], property func(T) bool) {
+	t.Helper()
+	CheckConfig(t, defaultConfig, gen, shrink, property)
+}
+
+// CheckConfig checks that property holds for values produced by gen using the given Config.
+// shrink can be nil, in which case failing values are reported as they are.
+func CheckConfig[T any](t testing.TB, c Config, gen Generator[T], shrink Shrinker[T], property func(T) bool) {
+	t.Helper()
+
+	if c.MaxCount <= 0 {
+		c.MaxCount = defaultConfig.MaxCount
+	}
+	if c.MaxSize <= 0 {
+		c.MaxSize = defaultConfig.MaxSize
+	}
+	if c.Seed == 0 {
+		seed, err := SeedFromEnv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Seed = seed
+	}
+
+	r := rand.New(rand.NewSource(c.Seed))
+
+	for i := 0; i < c.MaxCount; i++ {
+		// Grow the size with the number of checks, so small values are tried first
+		size := 1 + i*c.MaxSize/c.MaxCount
+		value := gen(r, size)
+
+		if property(value) {
+			continue
+		}
+
+		shrunk, steps := Shrink(value, shrink, property)
+		t.Errorf("property failed after %d checks (seed %d, rerun with %s=%d)\ninput: %v\nshrunk in %d steps to: %v",
+			i+1, c.Seed, SeedEnv, c.Seed, value, steps, shrunk)
+		return
+	}
+}
+
+// SeedFromEnv returns the seed set by SeedEnv, a new one from the current time when it is "random",
+// or DefaultSeed when it is not set.
+func SeedFromEnv() (int64, error) {
+	value := os.Getenv(SeedEnv)
+	switch value {
+	case "":
+		return DefaultSeed, nil
+	case "random":
+		return time.Now().UnixNano(), nil
+	}
+
+	seed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seed == 0 {
+		return 0, fmt.Errorf("prop: %s must be a non-zero number or \"random\", got %q", SeedEnv, value)
+	}
+	return seed, nil
+}
+
+// Shrink reduces value while property keeps failing. Returns the smallest failing value and the number of steps taken.
+func Shrink[T any](value T, shrink Shrinker[T], property func(T) bool) (T, int) {
+	if shrink == nil {
+		return value, 0
+	}
+
+	var steps int
+	for {
+		shrunk := false
+		for _, candidate := range shrink(value) {
+			if !property(candidate) {
+				value = candidate
+				shrunk = true
+				steps++
+				break
+			}
+		}
+
+		if !shrunk {
+			return value, steps
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package prop
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/iamlucasvieira/aoc/utils"
+)
+
+func TestShrink(t *testing.T) {
+	// Fails for every number larger than 10, so the smallest failing value is 11
+	property := func(n int) bool {
+		return n <= 10
+	}
+
+	got, steps := Shrink(1000, ShrinkInt, property)
+	if got != 11 {
+		t.Errorf("Shrink(1000) = %d, want %d", got, 11)
+	}
+
+	if steps == 0 {
+		t.Errorf("Shrink(1000) took no steps")
+	}
+}
+
+func TestShrinkNil(t *testing.T) {
+	got, steps := Shrink(1000, nil, func(int) bool { return false })
+	if got != 1000 || steps != 0 {
+		t.Errorf("Shrink(1000, nil) = %d, %d, want %d, %d", got, steps, 1000, 0)
+	}
+}
+
+func TestShrinkSlice(t *testing.T) {
+	// Fails when the slice contains a number larger than 10
+	property := func(s []int) bool {
+		return !slices.ContainsFunc(s, func(n int) bool { return n > 10 })
+	}
+
+	got, _ := Shrink([]int{3, 500, 7, 42}, ShrinkSlice(ShrinkInt, 0), property)
+	if !slices.Equal(got, []int{11}) {
+		t.Errorf("Shrink() = %v, want %v", got, []int{11})
+	}
+}
+
+func TestShrinkPoint(t *testing.T) {
+	// Fails when X is larger than 3
+	property := func(p utils.Point) bool {
+		return p.X <= 3
+	}
+
+	got, _ := Shrink(utils.Point{X: 100, Y: -50}, ShrinkPoint, property)
+	if want := (utils.Point{X: 4}); got != want {
+		t.Errorf("Shrink() = %v, want %v", got, want)
+	}
+}
+
+func TestCheckConfig(t *testing.T) {
+	var count int
+	c := Config{MaxCount: 30, MaxSize: 5, Seed: 1}
+	CheckConfig(t, c, Int(0, 10), nil, func(n int) bool {
+		count++
+		return n >= 0 && n <= 10
+	})
+
+	if count != 30 {
+		t.Errorf("CheckConfig checked %d values, want %d", count, 30)
+	}
+}
+
+func TestCheckConfigFailure(t *testing.T) {
+	// A fake testing.TB that records failures
+	ft := &fakeT{TB: t}
+	CheckConfig(ft, Config{Seed: 1}, Int(0, 100), ShrinkInt, func(n int) bool {
+		return n < 50
+	})
+
+	if !ft.failed {
+		t.Errorf("CheckConfig did not report the failing property")
+	}
+}
+
+func TestSeedFromEnv(t *testing.T) {
+	testCases := []struct {
+		env     string
+		want    int64
+		wantErr bool
+	}{
+		{"", DefaultSeed, false},
+		{"42", 42, false},
+		{"-7", -7, false},
+		{"0", 0, true},
+		{"abc", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.env, func(t *testing.T) {
+			t.Setenv(SeedEnv, tc.env)
+			got, err := SeedFromEnv()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("SeedFromEnv() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("SeedFromEnv() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("random", func(t *testing.T) {
+		t.Setenv(SeedEnv, "random")
+		if got, err := SeedFromEnv(); err != nil || got == 0 {
+			t.Errorf("SeedFromEnv() = %d, %v, want a random seed", got, err)
+		}
+	})
+}
+
+func TestCheckDeterministic(t *testing.T) {
+	// Without a seed in the Config or the environment, every run checks the same values
+	t.Setenv(SeedEnv, "")
+	var first, second []int
+	CheckConfig(t, Config{MaxCount: 20}, Int(0, 1000), nil, func(n int) bool {
+		first = append(first, n)
+		return true
+	})
+	CheckConfig(t, Config{MaxCount: 20}, Int(0, 1000), nil, func(n int) bool {
+		second = append(second, n)
+		return true
+	})
+
+	if !slices.Equal(first, second) {
+		t.Errorf("runs without a seed checked %v and %v", first, second)
+	}
+}
+
+type fakeT struct {
+	testing.TB
+	failed bool
+}
+
+func (f *fakeT) Errorf(string, ...any) { f.failed = true }
+func (f *fakeT) Helper()               {}
+
+func TestSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	gen := Slice(Int(1, 1), 2)
+
+	for size := 1; size < 10; size++ {
+		s := gen(r, size)
+		if len(s) < 2 || len(s) > max(2, size) {
+			t.Errorf("len(Slice(size=%d)) = %d", size, len(s))
+		}
+	}
+}
+
+func TestGrid(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	g := Grid(Int(7, 7))(r, 5)
+
+	if g.Width() < 1 || g.Width() > 5 || g.Height() < 1 || g.Height() > 5 {
+		t.Errorf("Grid has size %dx%d, want at most 5x5", g.Width(), g.Height())
+	}
+
+	for _, row := range g {
+		if len(row) != g.Width() {
+			t.Errorf("Grid is not rectangular")
+		}
+		for _, v := range row {
+			if v != 7 {
+				t.Errorf("Grid cell = %d, want %d", v, 7)
+			}
+		}
+	}
+}
+
+func TestPolygon(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for size := 1; size < 20; size++ {
+		polygon := Polygon()(r, size)
+
+		if polygon[0] != polygon[len(polygon)-1] {
+			t.Fatalf("polygon %v is not closed", polygon)
+		}
+
+		seen := make(map[utils.Point]bool)
+		for i, p := range polygon[:len(polygon)-1] {
+			if seen[p] {
+				t.Fatalf("polygon %v visits %v twice", polygon, p)
+			}
+			seen[p] = true
+
+			if p.Distance(polygon[i+1]) != 1 {
+				t.Fatalf("polygon %v jumps from %v to %v", polygon, p, polygon[i+1])
+			}
+
+			if p.X < 1 || p.Y < 1 || p.X > 2*size+1 || p.Y > 2*size+1 {
+				t.Fatalf("polygon point %v is outside the bounds for size %d", p, size)
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package prop
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/iamlucasvieira/aoc/utils"
+)
+
+// floodFillInside returns the points of g that cannot be reached from the border of g without crossing the polygon.
+// Points of the polygon itself are not inside.
+func floodFillInside(g utils.Grid[bool], polygon []utils.Point) map[utils.Point]bool {
+	onPolygon := make(map[utils.Point]bool)
+	for _, p := range polygon {
+		onPolygon[p] = true
+	}
+
+	outside := map[utils.Point]bool{{X: 0, Y: 0}: true}
+	queue := []utils.Point{{X: 0, Y: 0}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, d := range []utils.Point{{X: 0, Y: -1}, {X: 0, Y: 1}, {X: -1, Y: 0}, {X: 1, Y: 0}} {
+			next := current.Add(d)
+			if !g.Contains(next) || onPolygon[next] || outside[next] {
+				continue
+			}
+			outside[next] = true
+			queue = append(queue, next)
+		}
+	}
+
+	inside := make(map[utils.Point]bool)
+	for y := range g {
+		for x := range g[y] {
+			p := utils.Point{X: x, Y: y}
+			if !outside[p] && !onPolygon[p] {
+				inside[p] = true
+			}
+		}
+	}
+	return inside
+}
+
+func TestInsidePolygonMatchesFloodFill(t *testing.T) {
+	Check(t, Polygon(), func(polygon []utils.Point) bool {
+		// The polygon generator guarantees a border of at least one point around the polygon
+		var size int
+		for _, p := range polygon {
+			size = max(size, p.X+2, p.Y+2)
+		}
+		g := utils.NewGrid(size, size, false)
+
+		inside := floodFillInside(g, polygon)
+		for y := range g {
+			for x := range g[y] {
+				p := utils.Point{X: x, Y: y}
+				got, err := utils.InsidePolygon(p, g, polygon)
+				if err != nil || got != inside[p] {
+					t.Logf("InsidePolygon(%v) = %v, %v, flood fill says %v", p, got, err, inside[p])
+					return false
+				}
+			}
+		}
+		return true
+	})
+}
+
+func TestDistanceSymmetric(t *testing.T) {
+	Check(t, Slice(Point(), 2), func(p []utils.Point) bool {
+		return p[0].Distance(p[1]) == p[1].Distance(p[0])
+	})
+}
+
+func TestDistanceTriangleInequality(t *testing.T) {
+	Check(t, Slice(Point(), 3), func(p []utils.Point) bool {
+		return p[0].Distance(p[2]) <= p[0].Distance(p[1])+p[1].Distance(p[2])
+	})
+}
+
+func TestDistanceZero(t *testing.T) {
+	CheckShrink(t, Point(), ShrinkPoint, func(p utils.Point) bool {
+		return p.Distance(p) == 0
+	})
+}
+
+func TestLCMDivisibleByInputs(t *testing.T) {
+	// Inputs are kept small so the LCM does not overflow
+	gen := func(r *rand.Rand, size int) []int {
+		return Slice(Int(1, 50), 2)(r, min(size, 6))
+	}
+
+	CheckShrink(t, gen, ShrinkSlice[int](nil, 2), func(integers []int) bool {
+		lcm := utils.LCM(integers...)
+		for _, n := range integers {
+			if lcm%n != 0 {
+				return false
+			}
+		}
+		return true
+	})
+}
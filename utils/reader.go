@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
@@ -8,26 +10,69 @@ import (
 	"strings"
 )
 
-func ReadFile(fileName string) []string {
-	// Get the caller's file path
-	_, callerFilePath, _, ok := runtime.Caller(1)
-	if !ok {
-		log.Fatal("Could not get caller's file path")
+// ReadAll is a function that reads all text from r, with CRLF line endings normalised to LF
+// and trailing newlines removed.
+func ReadAll(r io.Reader) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
 	}
 
-	// Get the directory of the caller
-	callerDir := filepath.Dir(callerFilePath)
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	return strings.TrimRight(text, "\n"), nil
+}
 
-	// Construct the full path of the target file
-	fullPath := filepath.Join(callerDir, fileName)
+// ReadLines is a function that reads the lines from r, as returned by ReadAll.
+// Empty lines inside the text are kept, but there is no empty line for the final newline.
+func ReadLines(r io.Reader) ([]string, error) {
+	text, err := ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
 
-	data, err := os.ReadFile(fullPath)
+	if text == "" {
+		return []string{}, nil
+	}
+	return strings.Split(text, "\n"), nil
+}
+
+// LoadFile is a function that reads the lines of the file at path, as returned by ReadLines.
+func LoadFile(path string) ([]string, error) {
+	f, err := os.Open(path)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
+	defer f.Close()
 
-	// Transform into list of strings (one string per line)
-	lines := strings.Split(string(data), "\n")
+	lines, err := ReadLines(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return lines, nil
+}
 
+// callerPath is a function that returns the path of fileName in the directory of a calling source file.
+// skip is the number of stack frames to ascend, with 0 identifying the caller of callerPath.
+func callerPath(skip int, fileName string) (string, error) {
+	_, callerFilePath, _, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return "", fmt.Errorf("could not get caller's file path")
+	}
+	return filepath.Join(filepath.Dir(callerFilePath), fileName), nil
+}
+
+// ReadFile is a convenience function that reads the lines of a file in the directory of the calling source file,
+// so solutions can read their input wherever they are run from. It stops the program if the file can not be read.
+// Use LoadFile or ReadLines to handle errors.
+func ReadFile(fileName string) []string {
+	path, err := callerPath(1, fileName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	lines, err := LoadFile(path)
+	if err != nil {
+		log.Fatal(err)
+	}
 	return lines
 }
@@ -1,8 +1,12 @@
 package utils
 
 import (
+	"errors"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -37,3 +41,98 @@ func TestReadFile(t *testing.T) {
 		t.Errorf("ReadFile() = %v, want %v", result, expected)
 	}
 }
+
+// errReader is a reader that always fails
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestReadAll(t *testing.T) {
+	testCases := []TestCase[string, string]{
+		{Input: "", Expected: ""},
+		{Input: "a\nb", Expected: "a\nb"},
+		{Input: "a\nb\n", Expected: "a\nb"},
+		{Input: "a\nb\n\n\n", Expected: "a\nb"},
+		{Input: "a\r\nb\r\n", Expected: "a\nb"},
+		{Input: "a\r\n\r\nb", Expected: "a\n\nb"},
+		{Input: "\na", Expected: "\na"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strconv.Quote(tc.Input), func(t *testing.T) {
+			got, err := ReadAll(strings.NewReader(tc.Input))
+			if err != nil {
+				t.Fatalf("ReadAll() unexpected error: %s", err)
+			}
+			if got != tc.Expected {
+				t.Errorf("ReadAll() = %q, want %q", got, tc.Expected)
+			}
+		})
+	}
+
+	if _, err := ReadAll(errReader{}); err == nil {
+		t.Errorf("ReadAll() expected error")
+	}
+}
+
+func TestReadLines(t *testing.T) {
+	testCases := []TestCase[string, []string]{
+		{Input: "", Expected: []string{}},
+		{Input: "\n", Expected: []string{}},
+		{Input: "a", Expected: []string{"a"}},
+		{Input: "a\nb\n", Expected: []string{"a", "b"}},
+		{Input: "a\r\n\r\nb\r\n", Expected: []string{"a", "", "b"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strconv.Quote(tc.Input), func(t *testing.T) {
+			got, err := ReadLines(strings.NewReader(tc.Input))
+			if err != nil {
+				t.Fatalf("ReadLines() unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tc.Expected) {
+				t.Errorf("ReadLines() = %q, want %q", got, tc.Expected)
+			}
+		})
+	}
+
+	if _, err := ReadLines(errReader{}); err == nil {
+		t.Errorf("ReadLines() expected error")
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, []byte("line1\r\nline2\r\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %s", err)
+	}
+
+	got, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() unexpected error: %s", err)
+	}
+	if expected := []string{"line1", "line2"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("LoadFile() = %q, want %q", got, expected)
+	}
+
+	if _, err := LoadFile(filepath.Join(t.TempDir(), "missing.txt")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LoadFile() error = %v, want %v", err, os.ErrNotExist)
+	}
+}
+
+func TestCallerPath(t *testing.T) {
+	got, err := callerPath(0, "input.txt")
+	if err != nil {
+		t.Fatalf("callerPath() unexpected error: %s", err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %s", err)
+	}
+	if expected := filepath.Join(wd, "input.txt"); got != expected {
+		t.Errorf("callerPath() = %q, want %q", got, expected)
+	}
+}
@@ -0,0 +1,156 @@
+// Package render draws grids as images and collects them into animations, to debug solutions visually.
+package render
+
+import (
+	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// PathEnv is the environment variable the runner uses to pass the --render output path to the solvers.
+const PathEnv = "AOC_RENDER"
+
+// ColorFunc is a function that returns the colour of a grid cell.
+type ColorFunc[T any] func(T) color.Color
+
+// OutputPath is a function that returns the path a solver renders to, and whether rendering was requested.
+// The name is added before the extension so each part writes its own file, e.g. out.gif becomes out-part1.gif.
+func OutputPath(name string) (string, bool) {
+	path := os.Getenv(PathEnv)
+	if path == "" {
+		return "", false
+	}
+	ext := filepath.Ext(path)
+	return fmt.Sprintf("%s-%s%s", strings.TrimSuffix(path, ext), name, ext), true
+}
+
+// Image is a function that draws a grid, each cell as a square of scale by scale pixels.
+func Image[T any](grid utils.Grid[T], colorFunc ColorFunc[T], scale int) *image.RGBA {
+	scale = max(scale, 1)
+	img := image.NewRGBA(image.Rect(0, 0, grid.Width()*scale, grid.Height()*scale))
+	for y, row := range grid {
+		for x, value := range row {
+			rect := image.Rect(x*scale, y*scale, (x+1)*scale, (y+1)*scale)
+			draw.Draw(img, rect, image.NewUniform(colorFunc(value)), image.Point{}, draw.Src)
+		}
+	}
+	return img
+}
+
+// Animation collects grid frames to encode them as an animated GIF or a sequence of PNG images.
+type Animation[T any] struct {
+	ColorFunc ColorFunc[T]
+	Scale     int // Pixels per cell side
+	Delay     int // Delay between frames in 100ths of a second
+	frames    []*image.RGBA
+}
+
+// NewAnimation is a function that returns an empty Animation.
+func NewAnimation[T any](colorFunc ColorFunc[T], scale, delay int) *Animation[T] {
+	return &Animation[T]{ColorFunc: colorFunc, Scale: scale, Delay: delay}
+}
+
+// Add is a method that draws a grid as the next frame of the animation.
+func (a *Animation[T]) Add(grid utils.Grid[T]) {
+	a.frames = append(a.frames, Image(grid, a.ColorFunc, a.Scale))
+}
+
+// Len is a method that returns the number of frames in the animation.
+func (a *Animation[T]) Len() int {
+	return len(a.frames)
+}
+
+// Frames is a method that returns the frames of the animation.
+func (a *Animation[T]) Frames() []*image.RGBA {
+	return a.frames
+}
+
+// palette is a method that returns the colours used by the frames.
+// When there are more colours than a GIF supports, the Plan 9 palette is used instead.
+func (a *Animation[T]) palette() color.Palette {
+	seen := make(map[color.RGBA]bool)
+	var colors color.Palette
+	for _, frame := range a.frames {
+		for i := 0; i < len(frame.Pix); i += 4 {
+			c := color.RGBA{R: frame.Pix[i], G: frame.Pix[i+1], B: frame.Pix[i+2], A: frame.Pix[i+3]}
+			if seen[c] {
+				continue
+			}
+			if len(colors) == 256 {
+				return palette.Plan9
+			}
+			seen[c] = true
+			colors = append(colors, c)
+		}
+	}
+	return colors
+}
+
+// EncodeGIF is a method that writes the animation to w as an animated GIF.
+func (a *Animation[T]) EncodeGIF(w io.Writer) error {
+	if len(a.frames) == 0 {
+		return fmt.Errorf("animation has no frames")
+	}
+
+	p := a.palette()
+	anim := &gif.GIF{}
+	for _, frame := range a.frames {
+		paletted := image.NewPaletted(frame.Bounds(), p)
+		draw.Draw(paletted, frame.Bounds(), frame, image.Point{}, draw.Src)
+		anim.Image = append(anim.Image, paletted)
+		anim.Delay = append(anim.Delay, a.Delay)
+	}
+	return gif.EncodeAll(w, anim)
+}
+
+// Save is a method that writes the animation to a file.
+// A .gif path is written as an animated GIF, a .png path as one image per frame numbered before the extension.
+func (a *Animation[T]) Save(path string) error {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".gif":
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		if err := a.EncodeGIF(f); err != nil {
+			f.Close()
+			return err
+		}
+		return f.Close()
+	case ".png":
+		if len(a.frames) == 0 {
+			return fmt.Errorf("animation has no frames")
+		}
+		base := strings.TrimSuffix(path, filepath.Ext(path))
+		for i, frame := range a.frames {
+			if err := SavePNG(fmt.Sprintf("%s-%04d.png", base, i), frame); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported render format %q, expected .gif or .png", ext)
+	}
+}
+
+// SavePNG is a function that writes an image to a PNG file.
+func SavePNG(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
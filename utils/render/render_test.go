@@ -0,0 +1,171 @@
+package render
+
+import (
+	"bytes"
+	"github.com/iamlucasvieira/aoc/utils"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var (
+	black = color.RGBA{A: 255}
+	white = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+)
+
+func boolColor(b bool) color.Color {
+	if b {
+		return white
+	}
+	return black
+}
+
+func TestOutputPath(t *testing.T) {
+	testCases := []struct {
+		env    string
+		want   string
+		wantOk bool
+	}{
+		{"", "", false},
+		{"out.gif", "out-part1.gif", true},
+		{"frames/out.png", "frames/out-part1.png", true},
+		{"out", "out-part1", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.env, func(t *testing.T) {
+			t.Setenv(PathEnv, tc.env)
+			got, ok := OutputPath("part1")
+			if got != tc.want || ok != tc.wantOk {
+				t.Errorf("got %q, %v, want %q, %v", got, ok, tc.want, tc.wantOk)
+			}
+		})
+	}
+}
+
+func TestImage(t *testing.T) {
+	grid := utils.Grid[bool]{{true, false, false}, {false, true, false}}
+	img := Image(grid, boolColor, 2)
+
+	if got := img.Bounds().Size(); got.X != 6 || got.Y != 4 {
+		t.Fatalf("got size %v, want 6x4", got)
+	}
+
+	testCases := []struct {
+		x, y int
+		want color.RGBA
+	}{
+		{0, 0, white},
+		{1, 1, white},
+		{2, 0, black},
+		{3, 3, white},
+		{5, 3, black},
+	}
+
+	for _, tc := range testCases {
+		if got := img.RGBAAt(tc.x, tc.y); got != tc.want {
+			t.Errorf("pixel (%d, %d) = %v, want %v", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestAnimation_EncodeGIF(t *testing.T) {
+	anim := NewAnimation(boolColor, 1, 10)
+	if err := anim.EncodeGIF(&bytes.Buffer{}); err == nil {
+		t.Errorf("expected error for an animation without frames")
+	}
+
+	anim.Add(utils.Grid[bool]{{true, false}})
+	anim.Add(utils.Grid[bool]{{false, true}})
+
+	var buf bytes.Buffer
+	if err := anim.EncodeGIF(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	decoded, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error decoding: %v", err)
+	}
+	if len(decoded.Image) != 2 || decoded.Delay[1] != 10 {
+		t.Fatalf("got %d frames with delay %v, want 2 frames with delay 10", len(decoded.Image), decoded.Delay)
+	}
+
+	if r, _, _, _ := decoded.Image[1].At(1, 0).RGBA(); r != 0xffff {
+		t.Errorf("second frame should have a white pixel at (1, 0)")
+	}
+}
+
+func TestAnimation_palette(t *testing.T) {
+	anim := NewAnimation(func(i int) color.Color { return color.RGBA{R: uint8(i), G: uint8(i >> 8), A: 255} }, 1, 0)
+
+	anim.Add(utils.Grid[int]{{0, 1, 1, 2}})
+	if got := len(anim.palette()); got != 3 {
+		t.Errorf("got %d colours, want 3", got)
+	}
+
+	row := make([]int, 300)
+	for i := range row {
+		row[i] = i
+	}
+	anim.Add(utils.Grid[int]{row})
+	if got := len(anim.palette()); got != 256 {
+		t.Errorf("got %d colours, want the 256 colours of the fallback palette", got)
+	}
+}
+
+func TestAnimation_Save(t *testing.T) {
+	dir := t.TempDir()
+	anim := NewAnimation(boolColor, 1, 0)
+	anim.Add(utils.Grid[bool]{{true}})
+	anim.Add(utils.Grid[bool]{{false}})
+
+	testCases := []struct {
+		path  string
+		files []string
+	}{
+		{"out.gif", []string{"out.gif"}},
+		{"out.png", []string{"out-0000.png", "out-0001.png"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			if err := anim.Save(filepath.Join(dir, tc.path)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, file := range tc.files {
+				if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+					t.Errorf("expected file %s: %v", file, err)
+				}
+			}
+		})
+	}
+
+	if err := anim.Save(filepath.Join(dir, "out.jpg")); err == nil {
+		t.Errorf("expected error for an unsupported format")
+	}
+}
+
+func TestSavePNG(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "frame.png")
+	if err := SavePNG(path, Image(utils.Grid[bool]{{true, false}}, boolColor, 3)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+
+	img, err := png.Decode(f)
+	if err != nil {
+		t.Fatalf("unexpected error decoding: %v", err)
+	}
+	if got := img.Bounds().Size(); got.X != 6 || got.Y != 3 {
+		t.Errorf("got size %v, want 6x3", got)
+	}
+}
@@ -0,0 +1,114 @@
+package render
+
+import (
+	"fmt"
+	"github.com/iamlucasvieira/aoc/utils"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LiveEnv is the environment variable the runner uses to pass the --live frame rate to the solvers.
+const LiveEnv = "AOC_LIVE"
+
+const (
+	ansiHighlight = "\x1b[1;30;43m"
+	ansiReset     = "\x1b[0m"
+	ansiClear     = "\x1b[J"
+)
+
+// Terminal draws grids to a terminal, redrawing each frame in place at a frame rate.
+// When the writer is not a terminal, frames are written one after the other as plain text without delay.
+type Terminal[T any] struct {
+	CellFunc  func(T) rune
+	FrameRate int // Frames per second, 0 draws as fast as possible
+	w         io.Writer
+	tty       bool
+	lines     int // Lines of the previous frame, to move the cursor back over it
+	frames    int
+	sleep     func(time.Duration)
+}
+
+// NewTerminal is a function that returns a Terminal writing to w.
+func NewTerminal[T any](w io.Writer, cellFunc func(T) rune, frameRate int) *Terminal[T] {
+	return &Terminal[T]{CellFunc: cellFunc, FrameRate: frameRate, w: w, tty: isTerminal(w), sleep: time.Sleep}
+}
+
+// NewLiveTerminal is a function that returns a Terminal writing to stderr when the runner was given --live,
+// and whether live drawing was requested. Frames go to stderr so they never mix with the answers printed to stdout.
+func NewLiveTerminal[T any](cellFunc func(T) rune) (*Terminal[T], bool) {
+	frameRate, err := strconv.Atoi(os.Getenv(LiveEnv))
+	if err != nil || frameRate <= 0 {
+		return nil, false
+	}
+	return NewTerminal(os.Stderr, cellFunc, frameRate), true
+}
+
+// isTerminal is a function that checks if a writer is a character device, such as a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Frame is a method that returns the text of a grid, with the highlighted points in black on yellow on terminals.
+func (t *Terminal[T]) Frame(grid utils.Grid[T], highlight ...utils.Point) string {
+	highlighted := make(map[utils.Point]bool, len(highlight))
+	for _, p := range highlight {
+		highlighted[p] = true
+	}
+
+	var sb strings.Builder
+	for y, row := range grid {
+		for x, value := range row {
+			cell := t.CellFunc(value)
+			if t.tty && highlighted[utils.Point{X: x, Y: y}] {
+				sb.WriteString(ansiHighlight)
+				sb.WriteRune(cell)
+				sb.WriteString(ansiReset)
+			} else {
+				sb.WriteRune(cell)
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// Draw is a method that draws a grid as the next frame, replacing the previous frame on terminals.
+func (t *Terminal[T]) Draw(grid utils.Grid[T], highlight ...utils.Point) error {
+	var sb strings.Builder
+	if t.tty {
+		if t.lines > 0 {
+			fmt.Fprintf(&sb, "\x1b[%dA", t.lines)
+		}
+		sb.WriteString(ansiClear)
+	} else if t.frames > 0 {
+		sb.WriteByte('\n')
+	}
+	sb.WriteString(t.Frame(grid, highlight...))
+
+	if _, err := io.WriteString(t.w, sb.String()); err != nil {
+		return err
+	}
+	t.lines = grid.Height()
+	t.frames++
+
+	if t.tty && t.FrameRate > 0 {
+		t.sleep(time.Second / time.Duration(t.FrameRate))
+	}
+	return nil
+}
+
+// Frames is a method that returns the number of frames drawn.
+func (t *Terminal[T]) Frames() int {
+	return t.frames
+}
@@ -0,0 +1,107 @@
+package render
+
+import (
+	"bytes"
+	"github.com/iamlucasvieira/aoc/utils"
+	"strings"
+	"testing"
+	"time"
+)
+
+func boolRune(b bool) rune {
+	if b {
+		return '#'
+	}
+	return '.'
+}
+
+func TestTerminal_Frame(t *testing.T) {
+	grid := utils.Grid[bool]{{true, false}, {false, true}}
+
+	testCases := []struct {
+		name      string
+		tty       bool
+		highlight []utils.Point
+		want      string
+	}{
+		{"plain", false, nil, "#.\n.#\n"},
+		{"plain ignores highlight", false, []utils.Point{{X: 1, Y: 0}}, "#.\n.#\n"},
+		{"tty highlight", true, []utils.Point{{X: 1, Y: 0}}, "#" + ansiHighlight + "." + ansiReset + "\n.#\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			term := NewTerminal(&bytes.Buffer{}, boolRune, 0)
+			term.tty = tc.tty
+			if got := term.Frame(grid, tc.highlight...); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTerminal_DrawPlain(t *testing.T) {
+	var buf bytes.Buffer
+	term := NewTerminal(&buf, boolRune, 60)
+	term.sleep = func(time.Duration) { t.Fatalf("plain frames should not wait") }
+
+	for _, grid := range []utils.Grid[bool]{{{true}}, {{false}}} {
+		if err := term.Draw(grid); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if got, want := buf.String(), "#\n\n.\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if term.Frames() != 2 {
+		t.Errorf("got %d frames, want 2", term.Frames())
+	}
+}
+
+func TestTerminal_DrawTTY(t *testing.T) {
+	var buf bytes.Buffer
+	var waited []time.Duration
+	term := NewTerminal(&buf, boolRune, 4)
+	term.tty = true
+	term.sleep = func(d time.Duration) { waited = append(waited, d) }
+
+	grid := utils.Grid[bool]{{true}, {false}}
+	for i := 0; i < 2; i++ {
+		if err := term.Draw(grid); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if got, want := buf.String(), ansiClear+"#\n.\n"+"\x1b[2A"+ansiClear+"#\n.\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if len(waited) != 2 || waited[0] != 250*time.Millisecond {
+		t.Errorf("got waits %v, want two waits of 250ms", waited)
+	}
+}
+
+func TestNewLiveTerminal(t *testing.T) {
+	testCases := []struct {
+		env  string
+		want bool
+	}{
+		{"", false},
+		{"0", false},
+		{"invalid", false},
+		{"30", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.env, func(t *testing.T) {
+			t.Setenv(LiveEnv, tc.env)
+			term, ok := NewLiveTerminal(boolRune)
+			if ok != tc.want {
+				t.Fatalf("got %v, want %v", ok, tc.want)
+			}
+			if ok && (term.FrameRate != 30 || !strings.Contains(term.w.(interface{ Name() string }).Name(), "stderr")) {
+				t.Errorf("live terminal should draw to stdout at 30 fps")
+			}
+		})
+	}
+}
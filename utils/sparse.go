@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// SparseGrid is a grid without fixed bounds that only stores the points that were set.
+// Points can have negative coordinates, and points that were never set hold the default value.
+type SparseGrid[T any] struct {
+	Default T
+	cells   map[Point]T
+	bounds  Rect
+	stale   bool
+}
+
+// NewSparseGrid is a function that returns an empty SparseGrid where every point holds defaultValue.
+func NewSparseGrid[T any](defaultValue T) *SparseGrid[T] {
+	return &SparseGrid[T]{Default: defaultValue, cells: make(map[Point]T)}
+}
+
+// SparseGridFrom is a function that returns a SparseGrid with the values of a Grid.
+// Cells equal to defaultValue are not stored.
+func SparseGridFrom[T comparable](g Grid[T], defaultValue T) *SparseGrid[T] {
+	s := NewSparseGrid(defaultValue)
+	for y, row := range g {
+		for x, value := range row {
+			if value != defaultValue {
+				s.Set(Point{x, y}, value)
+			}
+		}
+	}
+	return s
+}
+
+// Get is a method that returns the value of a point in a SparseGrid, or the default value if it was not set.
+func (s *SparseGrid[T]) Get(p Point) T {
+	if value, ok := s.cells[p]; ok {
+		return value
+	}
+	return s.Default
+}
+
+// Lookup is a method that returns the value of a point in a SparseGrid and whether it was set.
+func (s *SparseGrid[T]) Lookup(p Point) (T, bool) {
+	value, ok := s.cells[p]
+	return value, ok
+}
+
+// Set is a method that sets the value of a point in a SparseGrid, growing its bounds if needed.
+func (s *SparseGrid[T]) Set(p Point, value T) {
+	if s.cells == nil {
+		s.cells = make(map[Point]T)
+	}
+
+	if !s.stale {
+		if len(s.cells) == 0 {
+			s.bounds = NewRect(p.X, p.Y, 1, 1)
+		} else if _, ok := s.cells[p]; !ok {
+			s.bounds = s.bounds.union(p)
+		}
+	}
+	s.cells[p] = value
+}
+
+// Delete is a method that removes a point from a SparseGrid, so it holds the default value again.
+func (s *SparseGrid[T]) Delete(p Point) {
+	if _, ok := s.cells[p]; !ok {
+		return
+	}
+	delete(s.cells, p)
+
+	// Bounds only shrink when a point on the edge is removed, they are then recomputed when needed
+	if p.X == s.bounds.Min.X || p.X == s.bounds.Max.X-1 || p.Y == s.bounds.Min.Y || p.Y == s.bounds.Max.Y-1 {
+		s.stale = true
+	}
+}
+
+// Len is a method that returns the number of points set in a SparseGrid.
+func (s *SparseGrid[T]) Len() int {
+	return len(s.cells)
+}
+
+// Bounds is a method that returns the smallest Rect containing every point set in a SparseGrid.
+// An empty SparseGrid has empty bounds.
+func (s *SparseGrid[T]) Bounds() Rect {
+	if len(s.cells) == 0 {
+		return Rect{}
+	}
+
+	if s.stale {
+		first := true
+		for p := range s.cells {
+			if first {
+				s.bounds = NewRect(p.X, p.Y, 1, 1)
+				first = false
+				continue
+			}
+			s.bounds = s.bounds.union(p)
+		}
+		s.stale = false
+	}
+	return s.bounds
+}
+
+// Points is a method that returns the points set in a SparseGrid in row-major order.
+func (s *SparseGrid[T]) Points() []Point {
+	points := make([]Point, 0, len(s.cells))
+	for p := range s.cells {
+		points = append(points, p)
+	}
+
+	slices.SortFunc(points, func(a, b Point) int {
+		return cmp.Or(cmp.Compare(a.Y, b.Y), cmp.Compare(a.X, b.X))
+	})
+	return points
+}
+
+// All is a method that returns an iterator over the points set in a SparseGrid and their values, in row-major order.
+func (s *SparseGrid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for _, p := range s.Points() {
+			if !yield(p, s.cells[p]) {
+				return
+			}
+		}
+	}
+}
+
+// Grid is a method that returns a dense Grid covering the bounds of a SparseGrid, and the point its top left cell is at.
+// A point p of the SparseGrid is at p.Sub(offset) in the Grid.
+func (s *SparseGrid[T]) Grid() (Grid[T], Point) {
+	bounds := s.Bounds()
+	g := NewGrid(bounds.Width(), bounds.Height(), s.Default)
+	for p, value := range s.cells {
+		g.Set(p.Sub(bounds.Min), value)
+	}
+	return g, bounds.Min
+}
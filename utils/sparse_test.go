@@ -0,0 +1,157 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSparseGrid_GetSet(t *testing.T) {
+	s := NewSparseGrid(".")
+	s.Set(Point{-2, 3}, "#")
+	s.Set(Point{5, -1}, "O")
+
+	testCases := []struct {
+		p      Point
+		want   string
+		wantOk bool
+	}{
+		{Point{-2, 3}, "#", true},
+		{Point{5, -1}, "O", true},
+		{Point{0, 0}, ".", false},
+		{Point{-100, 100}, ".", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.p.String(), func(t *testing.T) {
+			if got := s.Get(tc.p); got != tc.want {
+				t.Errorf("Get(%v) = %q, want %q", tc.p, got, tc.want)
+			}
+			if _, ok := s.Lookup(tc.p); ok != tc.wantOk {
+				t.Errorf("Lookup(%v) ok = %v, want %v", tc.p, ok, tc.wantOk)
+			}
+		})
+	}
+
+	if s.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", s.Len())
+	}
+}
+
+func TestSparseGrid_ZeroValue(t *testing.T) {
+	var s SparseGrid[int]
+	s.Set(Point{1, 1}, 3)
+
+	if got := s.Get(Point{1, 1}); got != 3 {
+		t.Errorf("got %d, want 3", got)
+	}
+	if got := s.Bounds(); got != NewRect(1, 1, 1, 1) {
+		t.Errorf("got %v, want %v", got, NewRect(1, 1, 1, 1))
+	}
+}
+
+func TestSparseGrid_Bounds(t *testing.T) {
+	s := NewSparseGrid(0)
+
+	if got := s.Bounds(); got != (Rect{}) {
+		t.Errorf("empty grid bounds = %v, want empty", got)
+	}
+
+	s.Set(Point{0, 0}, 1)
+	s.Set(Point{-3, 2}, 1)
+	s.Set(Point{4, -1}, 1)
+	s.Set(Point{1, 1}, 1)
+
+	if got, want := s.Bounds(), NewRect(-3, -1, 8, 4); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// Removing an inner point keeps the bounds, removing edge points shrinks them
+	s.Delete(Point{1, 1})
+	if got, want := s.Bounds(), NewRect(-3, -1, 8, 4); got != want {
+		t.Errorf("after inner delete got %v, want %v", got, want)
+	}
+
+	s.Delete(Point{-3, 2})
+	s.Delete(Point{4, -1})
+	if got, want := s.Bounds(), NewRect(0, 0, 1, 1); got != want {
+		t.Errorf("after edge delete got %v, want %v", got, want)
+	}
+
+	s.Delete(Point{0, 0})
+	if got := s.Bounds(); got != (Rect{}) {
+		t.Errorf("after deleting everything got %v, want empty", got)
+	}
+
+	s.Set(Point{7, 7}, 1)
+	if got, want := s.Bounds(), NewRect(7, 7, 1, 1); got != want {
+		t.Errorf("after set got %v, want %v", got, want)
+	}
+}
+
+func TestSparseGrid_Delete(t *testing.T) {
+	s := NewSparseGrid("?")
+	s.Set(Point{1, 2}, "x")
+	s.Delete(Point{1, 2})
+	s.Delete(Point{9, 9})
+
+	if got := s.Get(Point{1, 2}); got != "?" {
+		t.Errorf("got %q, want default", got)
+	}
+	if s.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", s.Len())
+	}
+}
+
+func TestSparseGrid_All(t *testing.T) {
+	s := NewSparseGrid("")
+	s.Set(Point{1, 0}, "b")
+	s.Set(Point{0, -1}, "a")
+	s.Set(Point{-5, 3}, "c")
+
+	var got []pointValue
+	for p, value := range s.All() {
+		got = append(got, pointValue{p, value})
+	}
+
+	want := []pointValue{{Point{0, -1}, "a"}, {Point{1, 0}, "b"}, {Point{-5, 3}, "c"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestSparseGrid_Grid(t *testing.T) {
+	s := NewSparseGrid(".")
+	s.Set(Point{-1, -1}, "#")
+	s.Set(Point{1, 0}, "#")
+
+	g, offset := s.Grid()
+
+	want := Grid[string]{
+		{"#", ".", "."},
+		{".", ".", "#"},
+	}
+	if !reflect.DeepEqual(g, want) {
+		t.Errorf("got %v, want %v", g, want)
+	}
+	if offset != (Point{-1, -1}) {
+		t.Errorf("offset = %v, want %v", offset, Point{-1, -1})
+	}
+}
+
+func TestSparseGridFrom(t *testing.T) {
+	g := Grid[string]{
+		{"#", "."},
+		{".", "#"},
+	}
+
+	s := SparseGridFrom(g, ".")
+	if s.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", s.Len())
+	}
+
+	// Converting back gives the same grid as long as its edges have set points
+	back, offset := s.Grid()
+	if !reflect.DeepEqual(back, g) || offset != (Point{}) {
+		t.Errorf("got %v at %v, want %v at (0, 0)", back, offset, g)
+	}
+}
@@ -0,0 +1,61 @@
+package utils
+
+// GridReader is an interface that represents a grid whose values can be read by point.
+// Both Grid and TiledGrid are GridReaders, so helpers written for one work on the other.
+type GridReader[T any] interface {
+	GridInterface
+	Get(p Point) T
+}
+
+// TiledGrid is a view of a Grid repeated infinitely in every direction.
+// Every point, including negative ones, maps to a cell of the Grid, so Contains is always true.
+type TiledGrid[T any] struct {
+	Grid Grid[T]
+}
+
+// NewTiledGrid is a function that returns a TiledGrid repeating a Grid.
+func NewTiledGrid[T any](g Grid[T]) TiledGrid[T] {
+	return TiledGrid[T]{Grid: g}
+}
+
+// Contains is a method that checks if a point is contained in a TiledGrid, which is true unless the Grid is empty.
+func (t TiledGrid[T]) Contains(p Point) bool {
+	return t.Width() > 0 && t.Height() > 0
+}
+
+// Width is a method that returns the width of a single tile.
+func (t TiledGrid[T]) Width() int {
+	return t.Grid.Width()
+}
+
+// Height is a method that returns the height of a single tile.
+func (t TiledGrid[T]) Height() int {
+	return t.Grid.Height()
+}
+
+// Wrap is a method that returns the point of the Grid a point of the TiledGrid is a copy of.
+func (t TiledGrid[T]) Wrap(p Point) Point {
+	return Point{floorMod(p.X, t.Width()), floorMod(p.Y, t.Height())}
+}
+
+// Tile is a method that returns the index of the tile a point is in, where the original Grid is tile (0, 0).
+// Tiles to the left and above the original Grid have negative indexes.
+func (t TiledGrid[T]) Tile(p Point) Point {
+	wrapped := t.Wrap(p)
+	return Point{(p.X - wrapped.X) / t.Width(), (p.Y - wrapped.Y) / t.Height()}
+}
+
+// Get is a method that returns the value of a point in a TiledGrid.
+func (t TiledGrid[T]) Get(p Point) T {
+	return t.Grid.Get(t.Wrap(p))
+}
+
+// Set is a method that sets the value of a point in a TiledGrid, which changes it in every tile.
+func (t TiledGrid[T]) Set(p Point, value T) {
+	t.Grid.Set(t.Wrap(p), value)
+}
+
+// floorMod is a function that returns a modulo n, always between 0 and n-1, also for negative a.
+func floorMod(a, n int) int {
+	return ((a % n) + n) % n
+}
@@ -0,0 +1,86 @@
+package utils
+
+import "testing"
+
+func TestTiledGrid_Get(t *testing.T) {
+	tiled := NewTiledGrid(Grid[string]{
+		{"a", "b", "c"},
+		{"d", "e", "f"},
+	})
+
+	testCases := []struct {
+		p        Point
+		want     string
+		wantWrap Point
+		wantTile Point
+	}{
+		{Point{0, 0}, "a", Point{0, 0}, Point{0, 0}},
+		{Point{2, 1}, "f", Point{2, 1}, Point{0, 0}},
+		{Point{3, 0}, "a", Point{0, 0}, Point{1, 0}},
+		{Point{7, 5}, "e", Point{1, 1}, Point{2, 2}},
+		{Point{-1, 0}, "c", Point{2, 0}, Point{-1, 0}},
+		{Point{-3, -1}, "d", Point{0, 1}, Point{-1, -1}},
+		{Point{-4, -3}, "f", Point{2, 1}, Point{-2, -2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.p.String(), func(t *testing.T) {
+			if got := tiled.Get(tc.p); got != tc.want {
+				t.Errorf("Get(%v) = %q, want %q", tc.p, got, tc.want)
+			}
+			if got := tiled.Wrap(tc.p); got != tc.wantWrap {
+				t.Errorf("Wrap(%v) = %v, want %v", tc.p, got, tc.wantWrap)
+			}
+			if got := tiled.Tile(tc.p); got != tc.wantTile {
+				t.Errorf("Tile(%v) = %v, want %v", tc.p, got, tc.wantTile)
+			}
+			if !tiled.Contains(tc.p) {
+				t.Errorf("Contains(%v) = false, want true", tc.p)
+			}
+		})
+	}
+}
+
+func TestTiledGrid_Set(t *testing.T) {
+	g := NewGrid(2, 2, 0)
+	tiled := NewTiledGrid(g)
+
+	tiled.Set(Point{-1, 5}, 7)
+
+	if g[1][1] != 7 {
+		t.Errorf("got %v, want the wrapped cell set", g)
+	}
+	if got := tiled.Get(Point{3, -1}); got != 7 {
+		t.Errorf("got %d, want 7 in every tile", got)
+	}
+}
+
+func TestTiledGrid_Empty(t *testing.T) {
+	tiled := NewTiledGrid(Grid[int]{})
+
+	if tiled.Contains(Point{0, 0}) {
+		t.Errorf("empty tiled grid should not contain points")
+	}
+}
+
+func TestGridReader(t *testing.T) {
+	g := Grid[int]{{1, 2}, {3, 4}}
+
+	// sum adds the values around the origin that the reader contains
+	sum := func(r GridReader[int]) int {
+		var total int
+		for _, p := range []Point{{-1, 0}, {0, 0}, {1, 0}, {0, 1}} {
+			if r.Contains(p) {
+				total += r.Get(p)
+			}
+		}
+		return total
+	}
+
+	if got := sum(g); got != 6 {
+		t.Errorf("grid sum = %d, want 6", got)
+	}
+	if got := sum(NewTiledGrid(g)); got != 8 {
+		t.Errorf("tiled grid sum = %d, want 8", got)
+	}
+}
@@ -0,0 +1,123 @@
+package utils
+
+// Rect is a struct that represents the rectangle of points with Min.X <= X < Max.X and Min.Y <= Y < Max.Y.
+type Rect struct {
+	Min, Max Point
+}
+
+// NewRect is a function that returns the rectangle with top left corner at (x, y) and the given width and height.
+func NewRect(x, y, width, height int) Rect {
+	return Rect{Min: Point{x, y}, Max: Point{x + width, y + height}}
+}
+
+// Width is a method that returns the width of a Rect.
+func (r Rect) Width() int {
+	return max(r.Max.X-r.Min.X, 0)
+}
+
+// Height is a method that returns the height of a Rect.
+func (r Rect) Height() int {
+	return max(r.Max.Y-r.Min.Y, 0)
+}
+
+// Contains is a method that checks if a point is inside a Rect.
+func (r Rect) Contains(p Point) bool {
+	return p.X >= r.Min.X && p.X < r.Max.X && p.Y >= r.Min.Y && p.Y < r.Max.Y
+}
+
+// Intersect is a method that returns the largest Rect contained by both Rects.
+// Rects that do not overlap intersect in an empty Rect.
+func (r Rect) Intersect(other Rect) Rect {
+	r.Min.X = max(r.Min.X, other.Min.X)
+	r.Min.Y = max(r.Min.Y, other.Min.Y)
+	r.Max.X = max(min(r.Max.X, other.Max.X), r.Min.X)
+	r.Max.Y = max(min(r.Max.Y, other.Max.Y), r.Min.Y)
+	return r
+}
+
+// union is a method that returns the smallest Rect containing a Rect and a point.
+func (r Rect) union(p Point) Rect {
+	r.Min.X = min(r.Min.X, p.X)
+	r.Min.Y = min(r.Min.Y, p.Y)
+	r.Max.X = max(r.Max.X, p.X+1)
+	r.Max.Y = max(r.Max.Y, p.Y+1)
+	return r
+}
+
+// Bounds is a method that returns the Rect covering a Grid.
+func (g Grid[T]) Bounds() Rect {
+	return NewRect(0, 0, g.Width(), g.Height())
+}
+
+// Row is a method that returns row y of a Grid.
+// The row is a view, so setting its values changes the Grid.
+func (g Grid[T]) Row(y int) []T {
+	return g[y]
+}
+
+// Col is a method that returns a copy of column x of a Grid, from top to bottom.
+func (g Grid[T]) Col(x int) []T {
+	col := make([]T, len(g))
+	for y, row := range g {
+		col[y] = row[x]
+	}
+	return col
+}
+
+// Sub is a method that returns the part of a Grid inside a Rect, clipped to the Grid.
+// The result is a view, so setting its values changes the original Grid.
+func (g Grid[T]) Sub(r Rect) Grid[T] {
+	r = r.Intersect(g.Bounds())
+	sub := make(Grid[T], r.Height())
+	for y := range sub {
+		row := g[r.Min.Y+y]
+		sub[y] = row[r.Min.X:r.Max.X:r.Max.X]
+	}
+	return sub
+}
+
+// Clone is a method that returns a copy of a Grid.
+func (g Grid[T]) Clone() Grid[T] {
+	clone := make(Grid[T], len(g))
+	for y, row := range g {
+		clone[y] = append([]T(nil), row...)
+	}
+	return clone
+}
+
+// Transpose is a method that returns a copy of a Grid mirrored along its main diagonal, so rows become columns.
+func (g Grid[T]) Transpose() Grid[T] {
+	return g.transform(g.Height(), g.Width(), func(x, y int) T { return g[x][y] })
+}
+
+// RotateCW is a method that returns a copy of a Grid rotated a quarter turn clockwise.
+func (g Grid[T]) RotateCW() Grid[T] {
+	return g.transform(g.Height(), g.Width(), func(x, y int) T { return g[g.Height()-1-x][y] })
+}
+
+// RotateCCW is a method that returns a copy of a Grid rotated a quarter turn counterclockwise.
+func (g Grid[T]) RotateCCW() Grid[T] {
+	return g.transform(g.Height(), g.Width(), func(x, y int) T { return g[x][g.Width()-1-y] })
+}
+
+// FlipH is a method that returns a copy of a Grid mirrored horizontally, so the left column becomes the right one.
+func (g Grid[T]) FlipH() Grid[T] {
+	return g.transform(g.Width(), g.Height(), func(x, y int) T { return g[y][g.Width()-1-x] })
+}
+
+// FlipV is a method that returns a copy of a Grid mirrored vertically, so the top row becomes the bottom one.
+func (g Grid[T]) FlipV() Grid[T] {
+	return g.transform(g.Width(), g.Height(), func(x, y int) T { return g[g.Height()-1-y][x] })
+}
+
+// transform is a method that returns a new Grid with the given size, where each value is taken from at.
+func (g Grid[T]) transform(width, height int, at func(x, y int) T) Grid[T] {
+	out := make(Grid[T], height)
+	for y := range out {
+		out[y] = make([]T, width)
+		for x := range out[y] {
+			out[y][x] = at(x, y)
+		}
+	}
+	return out
+}
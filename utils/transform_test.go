@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+// letterGrid is a 3x2 grid with distinct values, so every transform can be told apart.
+var letterGrid = Grid[string]{
+	{"a", "b", "c"},
+	{"d", "e", "f"},
+}
+
+func TestGrid_Transforms(t *testing.T) {
+	testCases := []struct {
+		name      string
+		transform func(Grid[string]) Grid[string]
+		want      Grid[string]
+	}{
+		{"transpose", Grid[string].Transpose, Grid[string]{{"a", "d"}, {"b", "e"}, {"c", "f"}}},
+		{"rotate cw", Grid[string].RotateCW, Grid[string]{{"d", "a"}, {"e", "b"}, {"f", "c"}}},
+		{"rotate ccw", Grid[string].RotateCCW, Grid[string]{{"c", "f"}, {"b", "e"}, {"a", "d"}}},
+		{"flip h", Grid[string].FlipH, Grid[string]{{"c", "b", "a"}, {"f", "e", "d"}}},
+		{"flip v", Grid[string].FlipV, Grid[string]{{"d", "e", "f"}, {"a", "b", "c"}}},
+		{"clone", Grid[string].Clone, letterGrid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.transform(letterGrid)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+
+			// Transforms are copies, so changing the result leaves the original untouched
+			got[0][0] = "z"
+			if letterGrid[0][0] != "a" {
+				t.Fatalf("transform changed the original grid")
+			}
+		})
+	}
+}
+
+func TestGrid_TransformsRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name      string
+		transform func(Grid[string]) Grid[string]
+	}{
+		{"rotate cw then ccw", func(g Grid[string]) Grid[string] { return g.RotateCW().RotateCCW() }},
+		{"rotate cw four times", func(g Grid[string]) Grid[string] { return g.RotateCW().RotateCW().RotateCW().RotateCW() }},
+		{"transpose twice", func(g Grid[string]) Grid[string] { return g.Transpose().Transpose() }},
+		{"flip h twice", func(g Grid[string]) Grid[string] { return g.FlipH().FlipH() }},
+		{"flip v twice", func(g Grid[string]) Grid[string] { return g.FlipV().FlipV() }},
+		{"flips are a half turn", func(g Grid[string]) Grid[string] { return g.FlipH().FlipV().RotateCW().RotateCW() }},
+		{"transpose is flip and rotation", func(g Grid[string]) Grid[string] { return g.Transpose().RotateCCW().FlipV() }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.transform(letterGrid); !reflect.DeepEqual(got, letterGrid) {
+				t.Errorf("got %v, want %v", got, letterGrid)
+			}
+		})
+	}
+}
+
+func TestGrid_RowCol(t *testing.T) {
+	g := letterGrid.Clone()
+
+	if got, want := g.Row(1), []string{"d", "e", "f"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Row(1) = %v, want %v", got, want)
+	}
+
+	if got, want := g.Col(2), []string{"c", "f"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Col(2) = %v, want %v", got, want)
+	}
+
+	// Rows are views and columns are copies
+	g.Row(0)[0] = "x"
+	g.Col(1)[0] = "y"
+	if g[0][0] != "x" || g[0][1] != "b" {
+		t.Errorf("got %v, want row view and column copy", g)
+	}
+}
+
+func TestGrid_Sub(t *testing.T) {
+	testCases := []struct {
+		name string
+		rect Rect
+		want Grid[string]
+	}{
+		{"inside", NewRect(1, 0, 2, 2), Grid[string]{{"b", "c"}, {"e", "f"}}},
+		{"single cell", NewRect(1, 1, 1, 1), Grid[string]{{"e"}}},
+		{"clipped", NewRect(2, 1, 5, 5), Grid[string]{{"f"}}},
+		{"whole grid", letterGrid.Bounds(), letterGrid},
+		{"outside", NewRect(5, 5, 2, 2), Grid[string]{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := letterGrid.Sub(tc.rect); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGrid_SubIsView(t *testing.T) {
+	g := letterGrid.Clone()
+	sub := g.Sub(NewRect(1, 1, 2, 1))
+
+	sub.Set(Point{0, 0}, "x")
+	if g[1][1] != "x" {
+		t.Errorf("got %v, want the original grid to change", g)
+	}
+
+	// Appending to a row of the view must not overwrite the rest of the original row
+	left := g.Sub(NewRect(0, 0, 1, 1))
+	left[0] = append(left[0], "y")
+	if g[0][1] != "b" {
+		t.Errorf("got %v, want append to leave the original grid untouched", g)
+	}
+}
+
+func TestRect_Intersect(t *testing.T) {
+	testCases := []struct {
+		name string
+		a, b Rect
+		want Rect
+	}{
+		{"overlap", NewRect(0, 0, 4, 4), NewRect(2, 2, 4, 4), NewRect(2, 2, 2, 2)},
+		{"contained", NewRect(0, 0, 4, 4), NewRect(1, 1, 1, 1), NewRect(1, 1, 1, 1)},
+		{"disjoint", NewRect(0, 0, 1, 1), NewRect(3, 3, 1, 1), NewRect(3, 3, 0, 0)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.a.Intersect(tc.b)
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			if got.Width() != tc.want.Width() || got.Height() != tc.want.Height() {
+				t.Errorf("got size %dx%d, want %dx%d", got.Width(), got.Height(), tc.want.Width(), tc.want.Height())
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Package vcd writes Value Change Dump files, to inspect how signals change over time in a waveform viewer.
+package vcd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// PathEnv is the environment variable the runner uses to pass the --vcd output path to the solvers.
+const PathEnv = "AOC_VCD"
+
+// OutputPath is a function that returns the path a solver writes its dump to, and whether it was requested.
+func OutputPath() (string, bool) {
+	path := os.Getenv(PathEnv)
+	return path, path != ""
+}
+
+// variable is a signal of a dump.
+type variable struct {
+	name  string
+	kind  string // wire or integer
+	width int
+	id    string
+	value int
+	set   bool
+}
+
+// change is a new value of a variable at a time.
+type change struct {
+	time  int
+	v     *variable
+	value int
+}
+
+// Dump is a set of signals and the changes of their values over time.
+type Dump struct {
+	Scope     string
+	Timescale string
+	vars      []*variable
+	index     map[string]*variable
+	changes   []change
+}
+
+// NewDump is a function that returns an empty Dump with signals in a scope.
+func NewDump(scope string) *Dump {
+	return &Dump{Scope: scope, Timescale: "1ns", index: make(map[string]*variable)}
+}
+
+// identifier is a function that returns the short code of the nth variable, using the printable ASCII characters.
+func identifier(n int) string {
+	const first, count = '!', '~' - '!' + 1
+	var id []byte
+	for {
+		id = append(id, byte(first+n%count))
+		n = n/count - 1
+		if n < 0 {
+			return string(id)
+		}
+	}
+}
+
+// declare is a method that adds a variable to the dump if it does not exist yet.
+func (d *Dump) declare(name, kind string, width int) error {
+	if v, ok := d.index[name]; ok {
+		if v.kind != kind || v.width != width {
+			return fmt.Errorf("variable %q is already declared as a %d bit %s", name, v.width, v.kind)
+		}
+		return nil
+	}
+	if strings.ContainsAny(name, " \t\n") {
+		return fmt.Errorf("variable name %q contains whitespace", name)
+	}
+	v := &variable{name: name, kind: kind, width: width, id: identifier(len(d.vars))}
+	d.vars = append(d.vars, v)
+	d.index[name] = v
+	return nil
+}
+
+// Wire is a method that declares a one bit signal.
+func (d *Dump) Wire(name string) error {
+	return d.declare(name, "wire", 1)
+}
+
+// Integer is a method that declares a signal holding integers of a width in bits.
+func (d *Dump) Integer(name string, width int) error {
+	return d.declare(name, "integer", width)
+}
+
+// Change is a method that sets the value of a signal at a time. Times must not decrease.
+// Changes to the value a signal already has are not recorded.
+func (d *Dump) Change(time int, name string, value int) error {
+	v, ok := d.index[name]
+	if !ok {
+		return fmt.Errorf("variable %q is not declared", name)
+	}
+	if len(d.changes) > 0 && time < d.changes[len(d.changes)-1].time {
+		return fmt.Errorf("change of %q at time %d is before time %d", name, time, d.changes[len(d.changes)-1].time)
+	}
+	if v.set && v.value == value {
+		return nil
+	}
+	v.value, v.set = value, true
+	d.changes = append(d.changes, change{time: time, v: v, value: value})
+	return nil
+}
+
+// valueString is a function that returns a value of a variable in VCD syntax.
+func valueString(v *variable, value int) string {
+	if v.width == 1 {
+		return strconv.Itoa(value&1) + v.id
+	}
+	return "b" + strconv.FormatInt(int64(value), 2) + " " + v.id
+}
+
+// String is a method that returns the dump in VCD syntax.
+func (d *Dump) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "$timescale %s $end\n", d.Timescale)
+	fmt.Fprintf(&sb, "$scope module %s $end\n", d.Scope)
+	for _, v := range d.vars {
+		fmt.Fprintf(&sb, "$var %s %d %s %s $end\n", v.kind, v.width, v.id, v.name)
+	}
+	sb.WriteString("$upscope $end\n$enddefinitions $end\n")
+
+	// All signals are unknown until they first change
+	sb.WriteString("#0\n$dumpvars\n")
+	for _, v := range d.vars {
+		if v.width == 1 {
+			fmt.Fprintf(&sb, "x%s\n", v.id)
+		} else {
+			fmt.Fprintf(&sb, "bx %s\n", v.id)
+		}
+	}
+	sb.WriteString("$end\n")
+
+	time := 0
+	for _, c := range d.changes {
+		if c.time != time {
+			fmt.Fprintf(&sb, "#%d\n", c.time)
+			time = c.time
+		}
+		sb.WriteString(valueString(c.v, c.value))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// WriteTo is a method that writes the dump in VCD syntax to w.
+func (d *Dump) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, d.String())
+	return int64(n), err
+}
+
+// Save is a method that writes the dump in VCD syntax to a file.
+func (d *Dump) Save(path string) error {
+	return os.WriteFile(path, []byte(d.String()), 0o644)
+}
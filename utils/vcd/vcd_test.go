@@ -0,0 +1,151 @@
+package vcd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIdentifier(t *testing.T) {
+	testCases := []struct {
+		n    int
+		want string
+	}{
+		{0, "!"},
+		{1, "\""},
+		{93, "~"},
+		{94, "!!"},
+		{95, "\"!"},
+		{94 + 94, "!\""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.want, func(t *testing.T) {
+			if got := identifier(tc.n); got != tc.want {
+				t.Errorf("identifier(%d) = %q, want %q", tc.n, got, tc.want)
+			}
+		})
+	}
+
+	seen := make(map[string]bool)
+	for n := 0; n < 20000; n++ {
+		id := identifier(n)
+		if seen[id] {
+			t.Fatalf("identifier(%d) = %q is repeated", n, id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestDump_String(t *testing.T) {
+	d := NewDump("circuit")
+	if err := d.Wire("a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := d.Integer("press", 8); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, c := range []struct {
+		time  int
+		name  string
+		value int
+	}{
+		{0, "press", 1},
+		{0, "a", 0},
+		{1, "a", 1},
+		{2, "a", 1}, // unchanged, not recorded
+		{3, "press", 2},
+		{3, "a", 0},
+	} {
+		if err := d.Change(c.time, c.name, c.value); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	want := strings.Join([]string{
+		"$timescale 1ns $end",
+		"$scope module circuit $end",
+		"$var wire 1 ! a $end",
+		"$var integer 8 \" press $end",
+		"$upscope $end",
+		"$enddefinitions $end",
+		"#0",
+		"$dumpvars",
+		"x!",
+		"bx \"",
+		"$end",
+		"b1 \"",
+		"0!",
+		"#1",
+		"1!",
+		"#3",
+		"b10 \"",
+		"0!",
+		"",
+	}, "\n")
+
+	if got := d.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestDump_Errors(t *testing.T) {
+	d := NewDump("circuit")
+	if err := d.Wire("a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		name string
+		err  error
+	}{
+		{"redeclared with another width", d.Integer("a", 4)},
+		{"whitespace in name", d.Wire("a b")},
+		{"undeclared", d.Change(0, "b", 1)},
+		{"time going back", func() error {
+			_ = d.Change(5, "a", 1)
+			return d.Change(4, "a", 0)
+		}()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+
+	if err := d.Wire("a"); err != nil {
+		t.Errorf("declaring the same wire twice should not fail: %v", err)
+	}
+}
+
+func TestDump_Save(t *testing.T) {
+	d := NewDump("circuit")
+	_ = d.Wire("a")
+	_ = d.Change(0, "a", 1)
+
+	path := filepath.Join(t.TempDir(), "out.vcd")
+	if err := d.Save(path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != d.String() {
+		t.Errorf("saved %q, %v, want %q", data, err, d.String())
+	}
+}
+
+func TestOutputPath(t *testing.T) {
+	t.Setenv(PathEnv, "")
+	if _, ok := OutputPath(); ok {
+		t.Errorf("no path should be requested")
+	}
+
+	t.Setenv(PathEnv, "out.vcd")
+	if path, ok := OutputPath(); !ok || path != "out.vcd" {
+		t.Errorf("got %q, %v, want out.vcd, true", path, ok)
+	}
+}
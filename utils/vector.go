@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Vector is a point with any number of integer coordinates, for puzzles in 4 or more dimensions.
+// Operations on two Vectors need them to have the same number of coordinates, and panic otherwise.
+type Vector[T Integer] []T
+
+// String is a method that returns a string representation of a Vector.
+func (v Vector[T]) String() string {
+	coordinates := make([]string, len(v))
+	for i, c := range v {
+		coordinates[i] = fmt.Sprint(c)
+	}
+	return "(" + strings.Join(coordinates, ", ") + ")"
+}
+
+// mustMatch is a method that panics when two Vectors have a different number of coordinates.
+func (v Vector[T]) mustMatch(v2 Vector[T]) {
+	if len(v) != len(v2) {
+		panic(fmt.Sprintf("vectors %v and %v have different dimensions", v, v2))
+	}
+}
+
+// Equal is a method that checks if a Vector is equal to another Vector.
+func (v Vector[T]) Equal(v2 Vector[T]) bool {
+	if len(v) != len(v2) {
+		return false
+	}
+	for i := range v {
+		if v[i] != v2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Add is a method that returns the sum of two Vectors.
+func (v Vector[T]) Add(v2 Vector[T]) Vector[T] {
+	v.mustMatch(v2)
+	sum := make(Vector[T], len(v))
+	for i := range v {
+		sum[i] = v[i] + v2[i]
+	}
+	return sum
+}
+
+// Sub is a method that returns the difference of two Vectors.
+func (v Vector[T]) Sub(v2 Vector[T]) Vector[T] {
+	v.mustMatch(v2)
+	diff := make(Vector[T], len(v))
+	for i := range v {
+		diff[i] = v[i] - v2[i]
+	}
+	return diff
+}
+
+// Scale is a method that returns a Vector with every coordinate multiplied by n.
+func (v Vector[T]) Scale(n T) Vector[T] {
+	scaled := make(Vector[T], len(v))
+	for i := range v {
+		scaled[i] = v[i] * n
+	}
+	return scaled
+}
+
+// Manhattan is a method that returns the Manhattan distance between two Vectors, the sum of the distances along each axis.
+func (v Vector[T]) Manhattan(v2 Vector[T]) T {
+	v.mustMatch(v2)
+	var distance T
+	for i := range v {
+		distance += Abs(v[i] - v2[i])
+	}
+	return distance
+}
+
+// Chebyshev is a method that returns the Chebyshev distance between two Vectors, the largest of the distances along each axis.
+func (v Vector[T]) Chebyshev(v2 Vector[T]) T {
+	v.mustMatch(v2)
+	var distance T
+	for i := range v {
+		distance = max(distance, Abs(v[i]-v2[i]))
+	}
+	return distance
+}
+
+// Neighbours is a method that returns the neighbours of a Vector that differ from it by one along a single axis.
+// A Vector in n dimensions has 2n of them, 6 in 3D.
+func (v Vector[T]) Neighbours() []Vector[T] {
+	neighbours := make([]Vector[T], 0, 2*len(v))
+	for axis := range v {
+		for _, step := range []T{-1, 1} {
+			n := make(Vector[T], len(v))
+			copy(n, v)
+			n[axis] += step
+			neighbours = append(neighbours, n)
+		}
+	}
+	return neighbours
+}
+
+// AllNeighbours is a method that returns every neighbour of a Vector, that differ from it by at most one along each axis.
+// A Vector in n dimensions has 3^n - 1 of them, 26 in 3D, and they are returned in lexicographic order.
+func (v Vector[T]) AllNeighbours() []Vector[T] {
+	offsets := []Vector[T]{{}}
+	for range v {
+		next := make([]Vector[T], 0, 3*len(offsets))
+		for _, o := range offsets {
+			for _, step := range []T{-1, 0, 1} {
+				next = append(next, append(o[:len(o):len(o)], step))
+			}
+		}
+		offsets = next
+	}
+
+	neighbours := make([]Vector[T], 0, len(offsets)-1)
+	for _, o := range offsets {
+		if o.isZero() {
+			continue
+		}
+		neighbours = append(neighbours, v.Add(o))
+	}
+	return neighbours
+}
+
+// isZero is a method that checks if every coordinate of a Vector is zero.
+func (v Vector[T]) isZero() bool {
+	for _, c := range v {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestVector_Arithmetic(t *testing.T) {
+	v, w := Vector[int]{1, 2, 3, 4}, Vector[int]{0, -2, 5, 1}
+
+	testCases := []struct {
+		name string
+		got  Vector[int]
+		want Vector[int]
+	}{
+		{"add", v.Add(w), Vector[int]{1, 0, 8, 5}},
+		{"sub", v.Sub(w), Vector[int]{1, 4, -2, 3}},
+		{"scale", v.Scale(3), Vector[int]{3, 6, 9, 12}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.got.Equal(tc.want) {
+				t.Errorf("got %v, want %v", tc.got, tc.want)
+			}
+		})
+	}
+
+	// Operations return new Vectors
+	if !v.Equal(Vector[int]{1, 2, 3, 4}) {
+		t.Errorf("operations changed the vector to %v", v)
+	}
+}
+
+func TestVector_Distances(t *testing.T) {
+	testCases := []struct {
+		v1, v2    Vector[int64]
+		manhattan int64
+		chebyshev int64
+	}{
+		{Vector[int64]{}, Vector[int64]{}, 0, 0},
+		{Vector[int64]{0, 0, 0, 0}, Vector[int64]{3, 0, 0, 0}, 3, 3},
+		{Vector[int64]{1, -1, 2, 0}, Vector[int64]{-1, 1, 2, 5}, 9, 5},
+		{Vector[int64]{1 << 60}, Vector[int64]{1<<60 + 1}, 1, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%v-%v", tc.v1, tc.v2), func(t *testing.T) {
+			if got := tc.v1.Manhattan(tc.v2); got != tc.manhattan {
+				t.Errorf("Manhattan() = %v, want %v", got, tc.manhattan)
+			}
+			if got := tc.v1.Chebyshev(tc.v2); got != tc.chebyshev {
+				t.Errorf("Chebyshev() = %v, want %v", got, tc.chebyshev)
+			}
+		})
+	}
+}
+
+func TestVector_DimensionMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("adding vectors of different dimensions should panic")
+		}
+	}()
+	Vector[int]{1, 2}.Add(Vector[int]{1, 2, 3})
+}
+
+func TestVector_Neighbours(t *testing.T) {
+	testCases := []struct {
+		v    Vector[int]
+		want int
+		all  int
+	}{
+		{Vector[int]{5}, 2, 2},
+		{Point{1, 1}.Vector(), 4, 8},
+		{Point3{1, 2, 3}.Vector(), 6, 26},
+		{Vector[int]{0, 0, 0, 0}, 8, 80},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.v.String(), func(t *testing.T) {
+			neighbours := tc.v.Neighbours()
+			if len(neighbours) != tc.want {
+				t.Errorf("got %d neighbours, want %d", len(neighbours), tc.want)
+			}
+			for _, n := range neighbours {
+				if tc.v.Manhattan(n) != 1 {
+					t.Errorf("neighbour %v is not one step from %v", n, tc.v)
+				}
+			}
+
+			all := tc.v.AllNeighbours()
+			if len(all) != tc.all {
+				t.Errorf("got %d neighbours including diagonals, want %d", len(all), tc.all)
+			}
+			seen := make(map[string]bool)
+			for _, n := range all {
+				if tc.v.Chebyshev(n) != 1 {
+					t.Errorf("neighbour %v is not one step from %v", n, tc.v)
+				}
+				if seen[n.String()] {
+					t.Errorf("neighbour %v is repeated", n)
+				}
+				seen[n.String()] = true
+			}
+		})
+	}
+}
+
+func TestVector_AllNeighboursOrder(t *testing.T) {
+	got := Vector[int]{0, 0}.AllNeighbours()
+	want := []Vector[int]{{-1, -1}, {-1, 0}, {-1, 1}, {0, -1}, {0, 1}, {1, -1}, {1, 0}, {1, 1}}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("got %v, want %v", got, want)
+			break
+		}
+	}
+}